@@ -0,0 +1,1335 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/reviewer/v1/reviewer.proto
+
+package reviewerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeamMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// junior, middle, senior или lead; пусто — middle
+	Seniority     string `protobuf:"bytes,4,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	FallbackTeams []string               `protobuf:"bytes,3,rep,name=fallback_teams,json=fallbackTeams,proto3" json:"fallback_teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Team) GetFallbackTeams() []string {
+	if x != nil {
+		return x.FallbackTeams
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Seniority     string                 `protobuf:"bytes,5,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+type FallbackReviewer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FallbackReviewer) Reset() {
+	*x = FallbackReviewer{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FallbackReviewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackReviewer) ProtoMessage() {}
+
+func (x *FallbackReviewer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackReviewer.ProtoReflect.Descriptor instead.
+func (*FallbackReviewer) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FallbackReviewer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FallbackReviewer) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type PullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// OPEN или MERGED
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	FallbackReviewers []*FallbackReviewer    `protobuf:"bytes,6,rep,name=fallback_reviewers,json=fallbackReviewers,proto3" json:"fallback_reviewers,omitempty"`
+	StaleReviewers    []string               `protobuf:"bytes,7,rep,name=stale_reviewers,json=staleReviewers,proto3" json:"stale_reviewers,omitempty"`
+	Labels            []string               `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetFallbackReviewers() []*FallbackReviewer {
+	if x != nil {
+		return x.FallbackReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetStaleReviewers() []string {
+	if x != nil {
+		return x.StaleReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type SetIsActiveRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// KEEP (по умолчанию) или REASSIGN
+	OnDeactivate string `protobuf:"bytes,3,opt,name=on_deactivate,json=onDeactivate,proto3" json:"on_deactivate,omitempty"`
+	// NONE (по умолчанию) или BACKFILL
+	OnReactivate  string `protobuf:"bytes,4,opt,name=on_reactivate,json=onReactivate,proto3" json:"on_reactivate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveRequest) Reset() {
+	*x = SetIsActiveRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveRequest) ProtoMessage() {}
+
+func (x *SetIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SetIsActiveRequest) GetOnDeactivate() string {
+	if x != nil {
+		return x.OnDeactivate
+	}
+	return ""
+}
+
+func (x *SetIsActiveRequest) GetOnReactivate() string {
+	if x != nil {
+		return x.OnReactivate
+	}
+	return ""
+}
+
+type AffectedPullRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// REASSIGNED, MARKED_STALE, STALE_CLEARED или BACKFILLED
+	Action         string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ReplacedBy     string   `protobuf:"bytes,3,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	AddedReviewers []string `protobuf:"bytes,4,rep,name=added_reviewers,json=addedReviewers,proto3" json:"added_reviewers,omitempty"`
+	Error          string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AffectedPullRequest) Reset() {
+	*x = AffectedPullRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AffectedPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedPullRequest) ProtoMessage() {}
+
+func (x *AffectedPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedPullRequest.ProtoReflect.Descriptor instead.
+func (*AffectedPullRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AffectedPullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *AffectedPullRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AffectedPullRequest) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+func (x *AffectedPullRequest) GetAddedReviewers() []string {
+	if x != nil {
+		return x.AddedReviewers
+	}
+	return nil
+}
+
+func (x *AffectedPullRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SetIsActiveResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	User                 *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	AffectedPullRequests []*AffectedPullRequest `protobuf:"bytes,2,rep,name=affected_pull_requests,json=affectedPullRequests,proto3" json:"affected_pull_requests,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetIsActiveResponse) Reset() {
+	*x = SetIsActiveResponse{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveResponse) ProtoMessage() {}
+
+func (x *SetIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SetIsActiveResponse) GetAffectedPullRequests() []*AffectedPullRequest {
+	if x != nil {
+		return x.AffectedPullRequests
+	}
+	return nil
+}
+
+type GetReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewsRequest) Reset() {
+	*x = GetReviewsRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewsRequest) ProtoMessage() {}
+
+func (x *GetReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewsResponse) Reset() {
+	*x = GetReviewsResponse{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewsResponse) ProtoMessage() {}
+
+func (x *GetReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewsResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ChangedFiles    []string               `protobuf:"bytes,4,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	Labels          []string               `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	// Если задан, замена назначается на этого пользователя
+	NewUserId     string `protobuf:"bytes,3,opt,name=new_user_id,json=newUserId,proto3" json:"new_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetNewUserId() string {
+	if x != nil {
+		return x.NewUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type ReviewerChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerChangeRequest) Reset() {
+	*x = ReviewerChangeRequest{}
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerChangeRequest) ProtoMessage() {}
+
+func (x *ReviewerChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_reviewer_v1_reviewer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerChangeRequest.ProtoReflect.Descriptor instead.
+func (*ReviewerChangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReviewerChangeRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReviewerChangeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+var File_api_proto_reviewer_v1_reviewer_proto protoreflect.FileDescriptor
+
+const file_api_proto_reviewer_v1_reviewer_proto_rawDesc = "" +
+	"\n" +
+	"$api/proto/reviewer/v1/reviewer.proto\x12\vreviewer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"|\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x1c\n" +
+	"\tseniority\x18\x04 \x01(\tR\tseniority\"}\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.reviewer.v1.TeamMemberR\amembers\x12%\n" +
+	"\x0efallback_teams\x18\x03 \x03(\tR\rfallbackTeams\"\x93\x01\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1c\n" +
+	"\tseniority\x18\x05 \x01(\tR\tseniority\"H\n" +
+	"\x10FallbackReviewer\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\"\xc8\x03\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x12L\n" +
+	"\x12fallback_reviewers\x18\x06 \x03(\v2\x1d.reviewer.v1.FallbackReviewerR\x11fallbackReviewers\x12'\n" +
+	"\x0fstale_reviewers\x18\a \x03(\tR\x0estaleReviewers\x12\x16\n" +
+	"\x06labels\x18\b \x03(\tR\x06labels\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\x9b\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"c\n" +
+	"\x11CreateTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.reviewer.v1.TeamMemberR\amembers\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"\x94\x01\n" +
+	"\x12SetIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12#\n" +
+	"\ron_deactivate\x18\x03 \x01(\tR\fonDeactivate\x12#\n" +
+	"\ron_reactivate\x18\x04 \x01(\tR\fonReactivate\"\xb5\x01\n" +
+	"\x13AffectedPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1f\n" +
+	"\vreplaced_by\x18\x03 \x01(\tR\n" +
+	"replacedBy\x12'\n" +
+	"\x0fadded_reviewers\x18\x04 \x03(\tR\x0eaddedReviewers\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x94\x01\n" +
+	"\x13SetIsActiveResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.reviewer.v1.UserR\x04user\x12V\n" +
+	"\x16affected_pull_requests\x18\x02 \x03(\v2 .reviewer.v1.AffectedPullRequestR\x14affectedPullRequests\",\n" +
+	"\x11GetReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"q\n" +
+	"\x12GetReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1d.reviewer.v1.PullRequestShortR\fpullRequests\"\xc8\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12#\n" +
+	"\rchanged_files\x18\x04 \x03(\tR\fchangedFiles\x12\x16\n" +
+	"\x06labels\x18\x05 \x03(\tR\x06labels\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"\x81\x01\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\x12\x1e\n" +
+	"\vnew_user_id\x18\x03 \x01(\tR\tnewUserId\"e\n" +
+	"\x18ReassignReviewerResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"X\n" +
+	"\x15ReviewerChangeRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId2\x89\x01\n" +
+	"\vTeamService\x12?\n" +
+	"\n" +
+	"CreateTeam\x12\x1e.reviewer.v1.CreateTeamRequest\x1a\x11.reviewer.v1.Team\x129\n" +
+	"\aGetTeam\x12\x1b.reviewer.v1.GetTeamRequest\x1a\x11.reviewer.v1.Team2\xae\x01\n" +
+	"\vUserService\x12P\n" +
+	"\vSetIsActive\x12\x1f.reviewer.v1.SetIsActiveRequest\x1a .reviewer.v1.SetIsActiveResponse\x12M\n" +
+	"\n" +
+	"GetReviews\x12\x1e.reviewer.v1.GetReviewsRequest\x1a\x1f.reviewer.v1.GetReviewsResponse2\xbc\x03\n" +
+	"\x12PullRequestService\x12T\n" +
+	"\x11CreatePullRequest\x12%.reviewer.v1.CreatePullRequestRequest\x1a\x18.reviewer.v1.PullRequest\x12R\n" +
+	"\x10MergePullRequest\x12$.reviewer.v1.MergePullRequestRequest\x1a\x18.reviewer.v1.PullRequest\x12_\n" +
+	"\x10ReassignReviewer\x12$.reviewer.v1.ReassignReviewerRequest\x1a%.reviewer.v1.ReassignReviewerResponse\x12K\n" +
+	"\vAddReviewer\x12\".reviewer.v1.ReviewerChangeRequest\x1a\x18.reviewer.v1.PullRequest\x12N\n" +
+	"\x0eRemoveReviewer\x12\".reviewer.v1.ReviewerChangeRequest\x1a\x18.reviewer.v1.PullRequestBLZJgithub.com/avito-tech-backend-autumn-2025/api/proto/reviewer/v1;reviewerv1b\x06proto3"
+
+var (
+	file_api_proto_reviewer_v1_reviewer_proto_rawDescOnce sync.Once
+	file_api_proto_reviewer_v1_reviewer_proto_rawDescData []byte
+)
+
+func file_api_proto_reviewer_v1_reviewer_proto_rawDescGZIP() []byte {
+	file_api_proto_reviewer_v1_reviewer_proto_rawDescOnce.Do(func() {
+		file_api_proto_reviewer_v1_reviewer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_reviewer_v1_reviewer_proto_rawDesc), len(file_api_proto_reviewer_v1_reviewer_proto_rawDesc)))
+	})
+	return file_api_proto_reviewer_v1_reviewer_proto_rawDescData
+}
+
+var file_api_proto_reviewer_v1_reviewer_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_proto_reviewer_v1_reviewer_proto_goTypes = []any{
+	(*TeamMember)(nil),               // 0: reviewer.v1.TeamMember
+	(*Team)(nil),                     // 1: reviewer.v1.Team
+	(*User)(nil),                     // 2: reviewer.v1.User
+	(*FallbackReviewer)(nil),         // 3: reviewer.v1.FallbackReviewer
+	(*PullRequest)(nil),              // 4: reviewer.v1.PullRequest
+	(*PullRequestShort)(nil),         // 5: reviewer.v1.PullRequestShort
+	(*CreateTeamRequest)(nil),        // 6: reviewer.v1.CreateTeamRequest
+	(*GetTeamRequest)(nil),           // 7: reviewer.v1.GetTeamRequest
+	(*SetIsActiveRequest)(nil),       // 8: reviewer.v1.SetIsActiveRequest
+	(*AffectedPullRequest)(nil),      // 9: reviewer.v1.AffectedPullRequest
+	(*SetIsActiveResponse)(nil),      // 10: reviewer.v1.SetIsActiveResponse
+	(*GetReviewsRequest)(nil),        // 11: reviewer.v1.GetReviewsRequest
+	(*GetReviewsResponse)(nil),       // 12: reviewer.v1.GetReviewsResponse
+	(*CreatePullRequestRequest)(nil), // 13: reviewer.v1.CreatePullRequestRequest
+	(*MergePullRequestRequest)(nil),  // 14: reviewer.v1.MergePullRequestRequest
+	(*ReassignReviewerRequest)(nil),  // 15: reviewer.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil), // 16: reviewer.v1.ReassignReviewerResponse
+	(*ReviewerChangeRequest)(nil),    // 17: reviewer.v1.ReviewerChangeRequest
+	(*timestamppb.Timestamp)(nil),    // 18: google.protobuf.Timestamp
+}
+var file_api_proto_reviewer_v1_reviewer_proto_depIdxs = []int32{
+	0,  // 0: reviewer.v1.Team.members:type_name -> reviewer.v1.TeamMember
+	3,  // 1: reviewer.v1.PullRequest.fallback_reviewers:type_name -> reviewer.v1.FallbackReviewer
+	18, // 2: reviewer.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	18, // 3: reviewer.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: reviewer.v1.CreateTeamRequest.members:type_name -> reviewer.v1.TeamMember
+	2,  // 5: reviewer.v1.SetIsActiveResponse.user:type_name -> reviewer.v1.User
+	9,  // 6: reviewer.v1.SetIsActiveResponse.affected_pull_requests:type_name -> reviewer.v1.AffectedPullRequest
+	5,  // 7: reviewer.v1.GetReviewsResponse.pull_requests:type_name -> reviewer.v1.PullRequestShort
+	4,  // 8: reviewer.v1.ReassignReviewerResponse.pr:type_name -> reviewer.v1.PullRequest
+	6,  // 9: reviewer.v1.TeamService.CreateTeam:input_type -> reviewer.v1.CreateTeamRequest
+	7,  // 10: reviewer.v1.TeamService.GetTeam:input_type -> reviewer.v1.GetTeamRequest
+	8,  // 11: reviewer.v1.UserService.SetIsActive:input_type -> reviewer.v1.SetIsActiveRequest
+	11, // 12: reviewer.v1.UserService.GetReviews:input_type -> reviewer.v1.GetReviewsRequest
+	13, // 13: reviewer.v1.PullRequestService.CreatePullRequest:input_type -> reviewer.v1.CreatePullRequestRequest
+	14, // 14: reviewer.v1.PullRequestService.MergePullRequest:input_type -> reviewer.v1.MergePullRequestRequest
+	15, // 15: reviewer.v1.PullRequestService.ReassignReviewer:input_type -> reviewer.v1.ReassignReviewerRequest
+	17, // 16: reviewer.v1.PullRequestService.AddReviewer:input_type -> reviewer.v1.ReviewerChangeRequest
+	17, // 17: reviewer.v1.PullRequestService.RemoveReviewer:input_type -> reviewer.v1.ReviewerChangeRequest
+	1,  // 18: reviewer.v1.TeamService.CreateTeam:output_type -> reviewer.v1.Team
+	1,  // 19: reviewer.v1.TeamService.GetTeam:output_type -> reviewer.v1.Team
+	10, // 20: reviewer.v1.UserService.SetIsActive:output_type -> reviewer.v1.SetIsActiveResponse
+	12, // 21: reviewer.v1.UserService.GetReviews:output_type -> reviewer.v1.GetReviewsResponse
+	4,  // 22: reviewer.v1.PullRequestService.CreatePullRequest:output_type -> reviewer.v1.PullRequest
+	4,  // 23: reviewer.v1.PullRequestService.MergePullRequest:output_type -> reviewer.v1.PullRequest
+	16, // 24: reviewer.v1.PullRequestService.ReassignReviewer:output_type -> reviewer.v1.ReassignReviewerResponse
+	4,  // 25: reviewer.v1.PullRequestService.AddReviewer:output_type -> reviewer.v1.PullRequest
+	4,  // 26: reviewer.v1.PullRequestService.RemoveReviewer:output_type -> reviewer.v1.PullRequest
+	18, // [18:27] is the sub-list for method output_type
+	9,  // [9:18] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_reviewer_v1_reviewer_proto_init() }
+func file_api_proto_reviewer_v1_reviewer_proto_init() {
+	if File_api_proto_reviewer_v1_reviewer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_reviewer_v1_reviewer_proto_rawDesc), len(file_api_proto_reviewer_v1_reviewer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_api_proto_reviewer_v1_reviewer_proto_goTypes,
+		DependencyIndexes: file_api_proto_reviewer_v1_reviewer_proto_depIdxs,
+		MessageInfos:      file_api_proto_reviewer_v1_reviewer_proto_msgTypes,
+	}.Build()
+	File_api_proto_reviewer_v1_reviewer_proto = out.File
+	file_api_proto_reviewer_v1_reviewer_proto_goTypes = nil
+	file_api_proto_reviewer_v1_reviewer_proto_depIdxs = nil
+}
@@ -13,15 +13,41 @@ func NewRouter(
 	teamHandler *handlers.TeamHandler,
 	userHandler *handlers.UserHandler,
 	prHandler *handlers.PRHandler,
+	ownershipHandler *handlers.OwnershipHandler,
+	absenceHandler *handlers.AbsenceHandler,
+	notificationHandler *handlers.NotificationHandler,
+	graphQLHandler *handlers.GraphQLHandler,
+	teamV2Handler *handlers.TeamV2Handler,
+	userV2Handler *handlers.UserV2Handler,
+	prV2Handler *handlers.PRV2Handler,
+	idempotencyMiddleware *handlers.IdempotencyMiddleware,
 	healthHandler *handlers.HealthHandler,
 ) *gin.Engine {
 	r := gin.Default()
+	// Idempotency-Key обрабатывается только на изменяющих маршрутах: запросы
+	// GraphQL и dry-run-проверки выполняются каждый раз заново
+	r.Use(idempotencyMiddleware.Except(
+		"/graphql",
+		"/pullRequest/preview",
+		"/team/explainPairing",
+		"/ownership/explain",
+	))
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	teamHandler.RegisterRoutes(r)
 	userHandler.RegisterRoutes(r)
 	prHandler.RegisterRoutes(r)
+	ownershipHandler.RegisterRoutes(r)
+	absenceHandler.RegisterRoutes(r)
+	notificationHandler.RegisterRoutes(r)
+	graphQLHandler.RegisterRoutes(r)
+
+	// Ресурсный API v2 работает параллельно с RPC-маршрутами v1 на тех же use cases
+	v2 := r.Group("/api/v2")
+	teamV2Handler.RegisterRoutes(v2)
+	userV2Handler.RegisterRoutes(v2)
+	prV2Handler.RegisterRoutes(v2)
 	healthHandler.RegisterRoutes(r)
 
 	return r
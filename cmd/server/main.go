@@ -4,19 +4,31 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	// Образ на alpine не содержит базы часовых поясов
+	_ "time/tzdata"
 
 	"github.com/avito-tech-backend-autumn-2025/api"
 	"github.com/avito-tech-backend-autumn-2025/internal/config"
 	"github.com/avito-tech-backend-autumn-2025/internal/database"
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/gql"
 	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/handlers"
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/rpc"
 	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/notification"
 	"github.com/avito-tech-backend-autumn-2025/internal/repository/postgres"
+	"github.com/avito-tech-backend-autumn-2025/internal/scheduler"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/absence"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/escalation"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/idempotency"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/ownership"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/reminder"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/team"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/user"
 )
@@ -36,29 +48,197 @@ func main() {
 	teamRepo := postgres.NewTeamRepository(db.DB)
 	userRepo := postgres.NewUserRepository(db.DB)
 	prRepo := postgres.NewPRRepository(db.DB)
+	ownershipRepo := postgres.NewOwnershipRepository(db.DB)
+	absenceRepo := postgres.NewAbsenceRepository(db.DB)
+	historyRepo := postgres.NewAssignmentHistoryRepository(db.DB)
+	escalationRepo := postgres.NewEscalationRepository(db.DB)
+	notificationSettingsRepo := postgres.NewNotificationSettingsRepository(db.DB)
+	idempotencyRepo := postgres.NewIdempotencyRepository(db.DB)
+	transactor := postgres.NewTransactor(db.DB)
 
-	reviewerAssigner := domain.NewReviewerAssigner()
+	clock := domain.SystemClock{}
+	random := domain.NewSystemRandomSource()
+	if cfg.RandomSeed != 0 {
+		log.Printf("Using fixed random seed %d", cfg.RandomSeed)
+		random = domain.NewRandomSource(cfg.RandomSeed)
+	}
+
+	reviewerAssigner := domain.NewReviewerAssigner(clock, random, cfg.ReviewSLA, domain.FairnessPolicy{
+		Window:  cfg.FairnessWindow,
+		MaxSkew: cfg.FairnessMaxSkew,
+	})
+
+	escalationAction, err := domain.ParseEscalationAction(cfg.EscalationAction)
+	if err != nil {
+		log.Fatalf("Invalid ESCALATION_ACTION %q", cfg.EscalationAction)
+	}
+	defaultSLAPolicy, err := domain.NewSLAPolicy(cfg.ReviewSLA, escalationAction)
+	if err != nil {
+		log.Fatalf("Invalid REVIEW_SLA %s", cfg.ReviewSLA)
+	}
 
 	createTeamUseCase := team.NewCreateTeamUseCase(teamRepo, userRepo)
 	getTeamUseCase := team.NewGetTeamUseCase(teamRepo)
-	setActiveUseCase := user.NewSetActiveUseCase(userRepo)
+	listTeamsUseCase := team.NewListTeamsUseCase(teamRepo)
+	setSeniorityRuleUseCase := team.NewSetSeniorityRuleUseCase(teamRepo)
+	setSLAPolicyUseCase := team.NewSetSLAPolicyUseCase(teamRepo)
+	setPairingRulesUseCase := team.NewSetPairingRulesUseCase(teamRepo)
+	explainPairingUseCase := team.NewExplainPairingUseCase(teamRepo, userRepo, clock)
+	setFallbackTeamsUseCase := team.NewSetFallbackTeamsUseCase(teamRepo)
+	setReviewersTargetUseCase := team.NewSetReviewersTargetUseCase(teamRepo)
 	getReviewsUseCase := user.NewGetReviewsUseCase(prRepo, userRepo)
-	createPRUseCase := pr.NewCreatePRUseCase(prRepo, userRepo, teamRepo, reviewerAssigner)
-	mergePRUseCase := pr.NewMergePRUseCase(prRepo)
-	reassignReviewerUseCase := pr.NewReassignReviewerUseCase(prRepo, userRepo, teamRepo, reviewerAssigner)
+	getUsersUseCase := user.NewGetUsersUseCase(userRepo)
+	getReviewsBatchUseCase := user.NewGetReviewsBatchUseCase(prRepo)
+	setTagsUseCase := user.NewSetTagsUseCase(userRepo)
+	getTagsUseCase := user.NewGetTagsUseCase(userRepo)
+	getUserUseCase := user.NewGetUserUseCase(userRepo)
+	setSeniorityUseCase := user.NewSetSeniorityUseCase(userRepo)
+	setScheduleUseCase := user.NewSetScheduleUseCase(userRepo)
+	createPRUseCase := pr.NewCreatePRUseCase(transactor, prRepo, userRepo, teamRepo, ownershipRepo, historyRepo, reviewerAssigner, clock)
+	mergePRUseCase := pr.NewMergePRUseCase(prRepo, clock)
+	getPRUseCase := pr.NewGetPRUseCase(prRepo)
+	listPRsUseCase := pr.NewListPRsUseCase(prRepo)
+	getPRsUseCase := pr.NewGetPRsUseCase(prRepo)
+	reassignReviewerUseCase := pr.NewReassignReviewerUseCase(transactor, prRepo, userRepo, teamRepo, historyRepo, reviewerAssigner, clock)
+	getHistoryUseCase := pr.NewGetHistoryUseCase(prRepo, historyRepo)
+	previewPRUseCase := pr.NewPreviewPRUseCase(userRepo, teamRepo, ownershipRepo, historyRepo, reviewerAssigner)
+	getReasoningUseCase := pr.NewGetReasoningUseCase(prRepo, historyRepo)
+	addReviewerUseCase := pr.NewAddReviewerUseCase(transactor, prRepo, userRepo, teamRepo, clock)
+	removeReviewerUseCase := pr.NewRemoveReviewerUseCase(transactor, prRepo, userRepo, teamRepo, clock)
+	batchCreatePRsUseCase := pr.NewBatchCreatePRsUseCase(transactor, ownershipRepo, reviewerAssigner, clock)
+	batchMergePRsUseCase := pr.NewBatchMergePRsUseCase(transactor, clock)
+	batchReassignReviewersUseCase := pr.NewBatchReassignReviewersUseCase(transactor, reviewerAssigner, clock)
+	addExtraReviewerUseCase := pr.NewAddExtraReviewerUseCase(transactor, prRepo, userRepo, teamRepo, historyRepo, reviewerAssigner, clock)
+	backfillReviewersUseCase := pr.NewBackfillReviewersUseCase(prRepo, addExtraReviewerUseCase)
+	setActiveUseCase := user.NewSetActiveUseCase(transactor, reviewerAssigner, clock)
+	updateUserUseCase := user.NewUpdateUserUseCase(transactor, reviewerAssigner, clock)
+	getEscalationsUseCase := escalation.NewGetEscalationsUseCase(prRepo, escalationRepo)
+	setOwnershipRulesUseCase := ownership.NewSetRulesUseCase(ownershipRepo, teamRepo, userRepo)
+	getOwnershipRulesUseCase := ownership.NewGetRulesUseCase(ownershipRepo)
+	explainOwnershipUseCase := ownership.NewExplainUseCase(ownershipRepo)
+	addAbsenceUseCase := absence.NewAddAbsenceUseCase(absenceRepo, userRepo)
+	getAbsencesUseCase := absence.NewGetAbsencesUseCase(absenceRepo, userRepo)
+	deleteAbsenceUseCase := absence.NewDeleteAbsenceUseCase(absenceRepo)
+	setNotificationSettingsUseCase := reminder.NewSetSettingsUseCase(notificationSettingsRepo, userRepo)
+	getNotificationSettingsUseCase := reminder.NewGetSettingsUseCase(notificationSettingsRepo, userRepo)
+	beginIdempotentUseCase := idempotency.NewBeginUseCase(idempotencyRepo, clock, cfg.IdempotencyKeyTTL)
+	completeIdempotentUseCase := idempotency.NewCompleteUseCase(idempotencyRepo)
+	purgeIdempotencyKeysUseCase := idempotency.NewPurgeExpiredUseCase(idempotencyRepo, clock)
+	reassignAbsentReviewersUseCase := absence.NewReassignAbsentReviewersUseCase(absenceRepo, prRepo, reassignReviewerUseCase)
+	sendDigestsUseCase := reminder.NewSendDigestsUseCase(prRepo, userRepo, notificationSettingsRepo, newNotifier(cfg), clock)
+	escalateOverdueReviewsUseCase := escalation.NewEscalateOverdueReviewsUseCase(
+		transactor, prRepo, teamRepo, reviewerAssigner, clock, defaultSLAPolicy,
+	)
 
-	teamHandler := handlers.NewTeamHandler(createTeamUseCase, getTeamUseCase)
-	userHandler := handlers.NewUserHandler(setActiveUseCase, getReviewsUseCase)
-	prHandler := handlers.NewPRHandler(createPRUseCase, mergePRUseCase, reassignReviewerUseCase)
+	teamHandler := handlers.NewTeamHandler(createTeamUseCase, getTeamUseCase, setSeniorityRuleUseCase, setSLAPolicyUseCase, setPairingRulesUseCase, explainPairingUseCase, setFallbackTeamsUseCase, setReviewersTargetUseCase)
+	userHandler := handlers.NewUserHandler(setActiveUseCase, getReviewsUseCase, setTagsUseCase, getTagsUseCase, setSeniorityUseCase, setScheduleUseCase)
+	prHandler := handlers.NewPRHandler(createPRUseCase, mergePRUseCase, reassignReviewerUseCase, getHistoryUseCase, getEscalationsUseCase, previewPRUseCase, getReasoningUseCase, addReviewerUseCase, removeReviewerUseCase, backfillReviewersUseCase, getPRUseCase, listPRsUseCase, batchCreatePRsUseCase, batchMergePRsUseCase, batchReassignReviewersUseCase)
+	ownershipHandler := handlers.NewOwnershipHandler(setOwnershipRulesUseCase, getOwnershipRulesUseCase, explainOwnershipUseCase)
+	absenceHandler := handlers.NewAbsenceHandler(addAbsenceUseCase, getAbsencesUseCase, deleteAbsenceUseCase)
+	notificationHandler := handlers.NewNotificationHandler(setNotificationSettingsUseCase, getNotificationSettingsUseCase)
 	healthHandler := handlers.NewHealthHandler()
 
-	router := api.NewRouter(teamHandler, userHandler, prHandler, healthHandler)
+	graphQLExecutor, err := gql.NewExecutor(
+		createTeamUseCase, getTeamUseCase, getUsersUseCase, getReviewsBatchUseCase, setActiveUseCase,
+		getPRsUseCase, createPRUseCase, mergePRUseCase, reassignReviewerUseCase, addReviewerUseCase, removeReviewerUseCase,
+	)
+	if err != nil {
+		log.Fatalf("Failed to build GraphQL schema: %v", err)
+	}
+	graphQLHandler := handlers.NewGraphQLHandler(graphQLExecutor)
+	teamV2Handler := handlers.NewTeamV2Handler(createTeamUseCase, getTeamUseCase, listTeamsUseCase)
+	userV2Handler := handlers.NewUserV2Handler(getUserUseCase, updateUserUseCase)
+	prV2Handler := handlers.NewPRV2Handler(getPRUseCase, reassignReviewerUseCase)
+	idempotencyMiddleware := handlers.NewIdempotencyMiddleware(beginIdempotentUseCase, completeIdempotentUseCase)
+
+	router := api.NewRouter(teamHandler, userHandler, prHandler, ownershipHandler, absenceHandler, notificationHandler, graphQLHandler, teamV2Handler, userV2Handler, prV2Handler, idempotencyMiddleware, healthHandler)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.ServerPort),
 		Handler: router,
 	}
 
+	grpcServer := rpc.NewServer(
+		rpc.NewTeamServer(createTeamUseCase, getTeamUseCase),
+		rpc.NewUserServer(setActiveUseCase, getReviewsUseCase),
+		rpc.NewPRServer(createPRUseCase, mergePRUseCase, reassignReviewerUseCase, addReviewerUseCase, removeReviewerUseCase),
+	)
+
+	jobs := scheduler.New()
+	jobs.Add(scheduler.Job{
+		Name:     "reassign-absent-reviewers",
+		Interval: cfg.AbsenceJobInterval,
+		Run: func(ctx context.Context) error {
+			results, err := reassignAbsentReviewersUseCase.Execute(clock.Now())
+			for _, result := range results {
+				if result.Err != nil {
+					log.Printf("Failed to reassign %s on PR %s: %v", result.OldUserID, result.PRID, result.Err)
+				} else {
+					log.Printf("Reassigned %s on PR %s to %s", result.OldUserID, result.PRID, result.ReplacedBy)
+				}
+			}
+			return err
+		},
+	})
+	jobs.Add(scheduler.Job{
+		Name:     "escalate-overdue-reviews",
+		Interval: cfg.EscalationJobInterval,
+		Run: func(ctx context.Context) error {
+			escalations, err := escalateOverdueReviewsUseCase.Execute()
+			for _, record := range escalations {
+				log.Printf("Escalated review of %s on PR %s: %s %s", record.ReviewerID, record.PRID, record.Action, record.NewReviewerID)
+			}
+			return err
+		},
+	})
+	jobs.Add(scheduler.Job{
+		Name:     "backfill-reviewers",
+		Interval: cfg.BackfillJobInterval,
+		Run: func(ctx context.Context) error {
+			report, err := backfillReviewersUseCase.Execute(pr.BackfillReviewersRequest{})
+			if report != nil {
+				for _, result := range report.Results {
+					if result.Err != nil {
+						log.Printf("Failed to backfill reviewers on PR %s: %v", result.PRID, result.Err)
+					} else if len(result.AddedReviewers) > 0 {
+						log.Printf("Backfilled PR %s with %v, still missing %d", result.PRID, result.AddedReviewers, result.Missing)
+					}
+				}
+			}
+			return err
+		},
+	})
+
+	jobs.Add(scheduler.Job{
+		Name:     "send-review-digests",
+		Interval: cfg.ReminderJobInterval,
+		Run: func(ctx context.Context) error {
+			results, err := sendDigestsUseCase.Execute(ctx)
+			for _, result := range results {
+				if result.Err != nil {
+					log.Printf("Failed to send review digest to %s: %v", result.UserID, result.Err)
+				}
+			}
+			return err
+		},
+	})
+
+	jobs.Add(scheduler.Job{
+		Name:     "purge-idempotency-keys",
+		Interval: cfg.IdempotencyPurgeJobInterval,
+		Run: func(ctx context.Context) error {
+			deleted, err := purgeIdempotencyKeysUseCase.Execute()
+			if deleted > 0 {
+				log.Printf("Purged %d expired idempotency keys", deleted)
+			}
+			return err
+		},
+	})
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	jobs.Start(jobsCtx)
+
 	go func() {
 		log.Printf("Server starting on port %d", cfg.ServerPort)
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -66,18 +246,51 @@ func main() {
 		}
 	}()
 
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
+	if err != nil {
+		log.Fatalf("Failed to listen on gRPC port %d: %v", cfg.GRPCPort, err)
+	}
+
+	go func() {
+		log.Printf("gRPC server starting on port %d", cfg.GRPCPort)
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Fatalf("gRPC server failed to start: %v", err)
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
 
+	stopJobs()
+	jobs.Wait()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
+	grpcServer.GracefulStop()
 
 	log.Println("Server exited")
 }
+
+func newNotifier(cfg *config.Config) reminder.Notifier {
+	switch cfg.Notifier {
+	case "smtp":
+		return notification.NewSMTPNotifier(notification.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.SMTPFrom,
+		})
+	case "webhook":
+		return notification.NewWebhookNotifier(cfg.WebhookURL)
+	default:
+		return notification.NewLogNotifier()
+	}
+}
@@ -15,32 +15,69 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/health": {
+        "/api/v2/pull-requests/{pull_request_id}": {
             "get": {
-                "description": "Проверка работоспособности сервиса",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает PR с ревьюерами и метками",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "PullRequests v2"
+                ],
+                "summary": "Получить PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор PR",
+                        "name": "pull_request_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag, полученный ранее",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Health check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.PullRequestDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Время последнего изменения"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Ресурс не изменился"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/pullRequest/create": {
+        "/api/v2/pull-requests/{pull_request_id}/reviewers/{reviewer_action}": {
             "post": {
-                "description": "Создаёт PR и автоматически назначает до 2 ревьюеров из команды автора",
+                "description": "Действие над ревьюером PR в виде {user_id}:{действие}. Поддерживается reassign: замена выбирается автоматически или берётся из new_user_id. Тело запроса необязательно",
                 "consumes": [
                     "application/json"
                 ],
@@ -48,25 +85,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "PullRequests v2"
                 ],
-                "summary": "Создать PR и назначить ревьюеров",
+                "summary": "Переназначить ревьюера",
                 "parameters": [
                     {
-                        "description": "Данные PR",
+                        "type": "string",
+                        "description": "Идентификатор PR",
+                        "name": "pull_request_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ревьюер и действие, например u2:reassign",
+                        "name": "reviewer_action",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Выбранная замена",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreatePRRequest"
+                            "$ref": "#/definitions/dto.ReassignToRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.PRResponse"
+                            "$ref": "#/definitions/dto.ReassignReviewerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
@@ -80,13 +148,37 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/pullRequest/merge": {
+        "/api/v2/teams": {
+            "get": {
+                "description": "Возвращает все команды с участниками, отсортированные по имени. Правила команды есть только в GET /api/v2/teams/{team_name}",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams v2"
+                ],
+                "summary": "Список команд",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TeamListResponse"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Помечает PR как MERGED (идемпотентная операция)",
+                "description": "Создаёт команду с участниками и возвращает её; адрес новой команды — в заголовке Location",
                 "consumes": [
                     "application/json"
                 ],
@@ -94,29 +186,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Teams v2"
                 ],
-                "summary": "Пометить PR как MERGED",
+                "summary": "Создать команду",
                 "parameters": [
                     {
-                        "description": "ID PR",
-                        "name": "request",
+                        "description": "Данные команды",
+                        "name": "team",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.MergePRRequest"
+                            "$ref": "#/definitions/dto.CreateTeamRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.PRResponse"
+                            "$ref": "#/definitions/dto.TeamDTO"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -124,45 +216,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/pullRequest/reassign": {
-            "post": {
-                "description": "Переназначает конкретного ревьювера на другого из его команды",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v2/teams/{team_name}": {
+            "get": {
+                "description": "Возвращает команду с участниками и правилами",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Teams v2"
                 ],
-                "summary": "Переназначить ревьюера",
+                "summary": "Получить команду",
                 "parameters": [
                     {
-                        "description": "Данные переназначения",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReassignReviewerRequest"
-                        }
+                        "type": "string",
+                        "description": "Имя команды",
+                        "name": "team_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag, полученный ранее",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ReassignReviewerResponse"
+                            "$ref": "#/definitions/dto.TeamDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Время последнего изменения"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "304": {
+                        "description": "Ресурс не изменился"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -170,49 +276,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/team/add": {
-            "post": {
-                "description": "Создаёт команду с участниками (создаёт/обновляет пользователей)",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v2/users/{user_id}": {
+            "get": {
+                "description": "Возвращает пользователя. ETag меняется при любом изменении пользователя, включая теги",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Users v2"
                 ],
-                "summary": "Создать команду с участниками",
+                "summary": "Получить пользователя",
                 "parameters": [
                     {
-                        "description": "Данные команды",
-                        "name": "team",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateTeamRequest"
-                        }
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag, полученный ранее",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TeamResponse"
+                            "$ref": "#/definitions/dto.UserDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Время последнего изменения"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Ресурс не изменился"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/team/get": {
-            "get": {
-                "description": "Получает команду с участниками по имени",
+            },
+            "patch": {
+                "description": "Меняет только переданные поля: is_active (с политиками on_deactivate и on_reactivate, как в /users/setIsActive) и seniority. Возвращает пользователя и затронутые открытые PR",
                 "consumes": [
                     "application/json"
                 ],
@@ -220,23 +344,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Users v2"
                 ],
-                "summary": "Получить команду с участниками",
+                "summary": "Частично обновить пользователя",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Уникальное имя команды",
-                        "name": "team_name",
-                        "in": "query",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Изменяемые поля",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TeamDTO"
+                            "$ref": "#/definitions/dto.SetActiveResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
@@ -244,13 +395,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/users/getReview": {
-            "get": {
-                "description": "Получает PR'ы, где пользователь назначен ревьюером",
+        "/graphql": {
+            "post": {
+                "description": "Выполняет запрос или мутацию по схеме Team → members → reviews → PR → reviewers. Ошибки полей возвращаются в errors с доменным кодом в extensions.code, статус ответа при этом 200",
                 "consumes": [
                     "application/json"
                 ],
@@ -258,27 +415,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "GraphQL"
                 ],
-                "summary": "Получить PR'ы пользователя",
+                "summary": "GraphQL-запрос",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Идентификатор пользователя",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Запрос, имя операции и переменные",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetReviewsResponse"
+                            "$ref": "#/definitions/dto.GraphQLResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -286,9 +445,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/setIsActive": {
+        "/health": {
+            "get": {
+                "description": "Проверка работоспособности сервиса",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/ownership/explain": {
             "post": {
-                "description": "Устанавливает флаг активности пользователя",
+                "description": "Показывает, какое правило сработало для каждого файла, ничего не сохраняя",
                 "consumes": [
                     "application/json"
                 ],
@@ -296,17 +478,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Ownership"
                 ],
-                "summary": "Установить флаг активности пользователя",
+                "summary": "Проверить правила владения (dry-run)",
                 "parameters": [
                     {
-                        "description": "Данные пользователя",
+                        "description": "Список изменённых файлов",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.SetActiveRequest"
+                            "$ref": "#/definitions/dto.ExplainOwnershipRequest"
                         }
                     }
                 ],
@@ -314,94 +496,2602 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserResponse"
+                            "$ref": "#/definitions/dto.ExplainOwnershipResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
+        },
+        "/ownership/getRules": {
+            "get": {
+                "description": "Возвращает текущие правила владения в порядке применения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ownership"
+                ],
+                "summary": "Получить правила владения кодом",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OwnershipRulesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ownership/setRules": {
+            "post": {
+                "description": "Полностью заменяет правила владения (glob-шаблон пути → команда и/или пользователи). Порядок важен: для файла действует последнее подходящее правило",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ownership"
+                ],
+                "summary": "Загрузить правила владения кодом",
+                "parameters": [
+                    {
+                        "description": "Правила владения",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetOwnershipRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OwnershipRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/addReviewer": {
+            "post": {
+                "description": "Добавляет указанного пользователя к ревьюерам PR. Пользователь должен быть активным участником команды автора, не автором и не назначенным ранее",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Назначить ревьюера вручную",
+                "parameters": [
+                    {
+                        "description": "PR и ревьюер",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewerChangeRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PRResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/backfill": {
+            "post": {
+                "description": "Находит открытые PR, у которых меньше ревьюеров, чем цель команды автора (reviewers_target, по умолчанию 2), и добирает недостающих из команды автора и её запасных команд по её правилам старшинства и пар. Возвращает отчёт по каждому такому PR: кто добавлен, сколько ещё не хватает и код ошибки, если PR обработать не удалось",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Добрать ревьюеров открытым PR",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BackfillResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/batchCreate": {
+            "post": {
+                "description": "Создаёт до 500 PR так же, как /pullRequest/create, и возвращает итог по каждому элементу. mode=BEST_EFFORT (по умолчанию) фиксирует пакет частями по 50 и пропускает неудавшиеся элементы; mode=ALL_OR_NOTHING при первой ошибке откатывает весь пакет",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Создать PR пакетом",
+                "parameters": [
+                    {
+                        "description": "Режим и PR",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchCreatePRsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/batchMerge": {
+            "post": {
+                "description": "Мёрджит до 500 PR и возвращает итог по каждому элементу. Режимы те же, что у /pullRequest/batchCreate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Смёрджить PR пакетом",
+                "parameters": [
+                    {
+                        "description": "Режим и PR",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchMergePRsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/batchReassign": {
+            "post": {
+                "description": "Переназначает ревьюеров в до 500 PR так же, как /pullRequest/reassign, и возвращает итог по каждому элементу с replaced_by. Режимы те же, что у /pullRequest/batchCreate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Переназначить ревьюеров пакетом",
+                "parameters": [
+                    {
+                        "description": "Режим и переназначения",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchReassignReviewersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/create": {
+            "post": {
+                "description": "Создаёт PR и автоматически назначает до 2 ревьюеров из команды автора, а также по одному владельцу на каждое сработавшее правило владения для changed_files. Кандидаты с тегами экспертизы, совпадающими с labels, имеют приоритет",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Создать PR и назначить ревьюеров",
+                "parameters": [
+                    {
+                        "description": "Данные PR",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatePRRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PRResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/get": {
+            "get": {
+                "description": "Возвращает PR с назначенными ревьюерами и метками",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Получить PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор PR",
+                        "name": "pull_request_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag, полученный ранее",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PRResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Время последнего изменения"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Ресурс не изменился"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/getEscalations": {
+            "get": {
+                "description": "Возвращает действия, выполненные из-за нарушения SLA первого ревью",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Получить эскалации PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор PR",
+                        "name": "pull_request_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EscalationsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/getHistory": {
+            "get": {
+                "description": "Возвращает назначения и переназначения ревьюеров PR вместе с seed, по которому можно воспроизвести выбор",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Получить историю назначений PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор PR",
+                        "name": "pull_request_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AssignmentHistoryResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/getReasoning": {
+            "get": {
+                "description": "Возвращает для каждого назначения и переназначения PR решение по каждому кандидату: выбран, не выбран или исключён, и почему",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Получить обоснование назначений PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор PR",
+                        "name": "pull_request_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AssignmentReasoningResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/list": {
+            "get": {
+                "description": "Возвращает страницу PR от новых к старым. Фильтры необязательны и объединяются по И: автор, команда автора, ревьюер, статус и интервал дат создания (YYYY-MM-DD, обе границы включительно). total — число PR под фильтром без учёта страницы",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Список PR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Автор",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Команда автора",
+                        "name": "team_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Назначенный ревьюер",
+                        "name": "reviewer_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "OPEN",
+                            "MERGED"
+                        ],
+                        "type": "string",
+                        "description": "Статус",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Создан не раньше, YYYY-MM-DD",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Создан не позже, YYYY-MM-DD",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 20, не больше 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PRListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/merge": {
+            "post": {
+                "description": "Помечает PR как MERGED (идемпотентная операция)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Пометить PR как MERGED",
+                "parameters": [
+                    {
+                        "description": "ID PR",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MergePRRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PRResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/preview": {
+            "post": {
+                "description": "Выполняет тот же подбор ревьюеров, что и создание PR, но ничего не сохраняет. Возвращает выбранных ревьюеров и решение по каждому кандидату с причиной: выбран, не выбран или исключён",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Предпросмотр назначения ревьюеров",
+                "parameters": [
+                    {
+                        "description": "Автор, изменённые файлы и метки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PreviewPRRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PreviewPRResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/reassign": {
+            "post": {
+                "description": "Переназначает конкретного ревьювера на другого из его команды. Если передан new_user_id, замена назначается на этого пользователя, иначе выбирается автоматически",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Переназначить ревьюера",
+                "parameters": [
+                    {
+                        "description": "Данные переназначения",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReassignReviewerRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReassignReviewerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pullRequest/removeReviewer": {
+            "post": {
+                "description": "Убирает ревьюера из PR, не назначая замену. Запрещено, если без него нарушится правило старшинства команды автора",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Снять ревьюера без замены",
+                "parameters": [
+                    {
+                        "description": "PR и ревьюер",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewerChangeRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PRResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/add": {
+            "post": {
+                "description": "Создаёт команду с участниками (создаёт/обновляет пользователей)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Создать команду с участниками",
+                "parameters": [
+                    {
+                        "description": "Данные команды",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/explainPairing": {
+            "post": {
+                "description": "Показывает для каждого участника команды автора, исключён ли он, обязателен или предпочтителен и какое правило сработало. Ничего не сохраняет",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Проверить правила пар для автора (dry-run)",
+                "parameters": [
+                    {
+                        "description": "Автор PR",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExplainPairingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExplainPairingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/get": {
+            "get": {
+                "description": "Получает команду с участниками по имени",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Получить команду с участниками",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Уникальное имя команды",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag, полученный ранее",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Время последнего изменения"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Ресурс не изменился"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/setFallbackTeams": {
+            "post": {
+                "description": "Полностью заменяет запасные команды. К ним по порядку обращаются, когда в команде не хватает свободных кандидатов в ревьюеры. Пустой список снимает запасные команды",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Установить запасные команды",
+                "parameters": [
+                    {
+                        "description": "Запасные команды в порядке обращения",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetFallbackTeamsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/setPairingRules": {
+            "post": {
+                "description": "Полностью заменяет правила команды: NEVER_PAIR (пара никогда не ревьюит друг друга), PREFER_PAIR (ревьюер выбирается в первую очередь для PR автора), ALWAYS_INCLUDE (ревьюер всегда назначается на PR автора, а без author_id — на любой PR команды)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Установить правила пар автор–ревьюер",
+                "parameters": [
+                    {
+                        "description": "Правила пар",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetPairingRulesRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/setReviewersTarget": {
+            "post": {
+                "description": "Задаёт, сколько ревьюеров назначается на PR авторов команды и до скольких их добирает /pullRequest/backfill. 0 возвращает значение по умолчанию (2)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Установить число ревьюеров на PR",
+                "parameters": [
+                    {
+                        "description": "Цель по числу ревьюеров",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetReviewersTargetRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/setSLA": {
+            "post": {
+                "description": "Задаёт срок первого ревью (Go duration, например 24h) для PR авторов команды и действие при его нарушении: ADD_REVIEWER, REPLACE_REVIEWER или NOTIFY. Пустой review_sla снимает политику",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Установить SLA первого ревью команды",
+                "parameters": [
+                    {
+                        "description": "Политика SLA",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetSLAPolicyRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/setSeniorityRule": {
+            "post": {
+                "description": "Требует не меньше min_reviewers ревьюеров уровня min_level или выше на каждом PR команды. min_reviewers = 0 снимает правило",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Установить правило старшинства ревьюеров",
+                "parameters": [
+                    {
+                        "description": "Правило старшинства",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetSeniorityRuleRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/addAbsence": {
+            "post": {
+                "description": "Добавляет период отсутствия пользователя (даты включительно, формат YYYY-MM-DD). В этот период пользователь не назначается ревьюером",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Добавить период отсутствия",
+                "parameters": [
+                    {
+                        "description": "Период отсутствия",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AddAbsenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AbsenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/deleteAbsence": {
+            "post": {
+                "description": "Удаляет период отсутствия по идентификатору",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Удалить период отсутствия",
+                "parameters": [
+                    {
+                        "description": "Идентификатор периода",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteAbsenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/getAbsences": {
+            "get": {
+                "description": "Возвращает все периоды отсутствия пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить периоды отсутствия пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetAbsencesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/getNotifications": {
+            "get": {
+                "description": "Возвращает настройки дайджестов пользователя. Без сохранённых настроек дайджест отправляется ежедневно",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить настройки напоминаний",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationSettingsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/getReview": {
+            "get": {
+                "description": "Получает PR'ы, где пользователь назначен ревьюером",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить PR'ы пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetReviewsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/getTags": {
+            "get": {
+                "description": "Возвращает теги экспертизы пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Получить теги экспертизы пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserTagsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setIsActive": {
+            "post": {
+                "description": "Устанавливает флаг активности пользователя и применяет политику к его открытым ревью. При деактивации ревью переназначаются (on_deactivate=REASSIGN) или остаются с отметкой об устаревании (KEEP, по умолчанию). При возвращении отметки снимаются, а с on_reactivate=BACKFILL пользователь назначается ревьюером открытых PR своей команды, которым не хватает ревьюеров. Возвращает затронутые PR",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Установить флаг активности пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные пользователя",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetActiveRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetActiveResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setNotifications": {
+            "post": {
+                "description": "Устанавливает адрес для дайджестов и их частоту: DAILY, WEEKLY или OFF (отказ от напоминаний)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Установить настройки напоминаний",
+                "parameters": [
+                    {
+                        "description": "Настройки напоминаний",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetNotificationsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setSchedule": {
+            "post": {
+                "description": "Устанавливает часовой пояс (IANA) и рабочие часы (пн-пт). Пустые work_start и work_end снимают ограничение",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Установить часовой пояс и рабочее время пользователя",
+                "parameters": [
+                    {
+                        "description": "Расписание пользователя",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetScheduleRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setSeniority": {
+            "post": {
+                "description": "Устанавливает уровень пользователя: junior, middle, senior или lead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Установить уровень пользователя",
+                "parameters": [
+                    {
+                        "description": "Уровень пользователя",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetSeniorityRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setTags": {
+            "post": {
+                "description": "Полностью заменяет теги экспертизы (например, go, postgres, frontend). Теги приводятся к нижнему регистру",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Установить теги экспертизы пользователя",
+                "parameters": [
+                    {
+                        "description": "Теги пользователя",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetTagsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ожидаемая версия ресурса (ETag)",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserTagsResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия ресурса"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AbsenceDTO": {
+            "type": "object",
+            "properties": {
+                "absence_id": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AbsenceResponse": {
+            "type": "object",
+            "properties": {
+                "absence": {
+                    "$ref": "#/definitions/dto.AbsenceDTO"
+                }
+            }
+        },
+        "dto.AddAbsenceRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "start_date",
+                "user_id"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.AffectedPRDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "added_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "replaced_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AssignmentHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AssignmentRecordDTO"
+                    }
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AssignmentReasoningDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "candidates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CandidateDecisionDTO"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "seed": {
+                    "type": "string",
+                    "example": "0"
+                }
+            }
+        },
+        "dto.AssignmentReasoningResponse": {
+            "type": "object",
+            "properties": {
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "reasoning": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AssignmentReasoningDTO"
+                    }
+                }
+            }
+        },
+        "dto.AssignmentRecordDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "replaced_reviewer_id": {
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "seed": {
+                    "type": "string",
+                    "example": "0"
+                }
+            }
+        },
+        "dto.BackfillResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BackfillResultDTO"
+                    }
+                },
+                "scanned": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.BackfillResultDTO": {
+            "type": "object",
+            "properties": {
+                "added_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "fallback_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FallbackReviewerDTO"
+                    }
+                },
+                "missing": {
+                    "type": "integer"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BatchCreatePRsRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.CreatePRRequest"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "dto.BatchMergePRsRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.MergePRRequest"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "dto.BatchReassignReviewersRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.ReassignReviewerRequest"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "dto.BatchResponse": {
+            "type": "object",
+            "properties": {
+                "aborted": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BatchResultDTO"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.BatchResultDTO": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "pr": {
+                    "$ref": "#/definitions/dto.PullRequestDTO"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "replaced_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CandidateDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "rank": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.CreatePRRequest": {
             "type": "object",
+            "required": [
+                "author_id",
+                "changed_files",
+                "labels",
+                "pull_request_id",
+                "pull_request_name"
+            ],
             "properties": {
                 "author_id": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "changed_files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "pull_request_id": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "pull_request_name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
         "dto.CreateTeamRequest": {
             "type": "object",
+            "required": [
+                "team_name"
+            ],
             "properties": {
                 "members": {
                     "type": "array",
+                    "uniqueItems": true,
                     "items": {
                         "$ref": "#/definitions/dto.TeamMemberDTO"
                     }
                 },
-                "team_name": {
+                "team_name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.DeleteAbsenceRequest": {
+            "type": "object",
+            "required": [
+                "absence_id"
+            ],
+            "properties": {
+                "absence_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ErrorDetail": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/dto.ErrorDetail"
+                }
+            }
+        },
+        "dto.EscalationDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "new_reviewer_id": {
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EscalationsResponse": {
+            "type": "object",
+            "properties": {
+                "escalations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EscalationDTO"
+                    }
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ExplainOwnershipRequest": {
+            "type": "object",
+            "required": [
+                "changed_files"
+            ],
+            "properties": {
+                "changed_files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ExplainOwnershipResponse": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FileOwnershipDTO"
+                    }
+                }
+            }
+        },
+        "dto.ExplainPairingRequest": {
+            "type": "object",
+            "required": [
+                "author_id"
+            ],
+            "properties": {
+                "author_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ExplainPairingResponse": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "candidates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PairingDecisionDTO"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FallbackReviewerDTO": {
+            "type": "object",
+            "properties": {
+                "team_name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FileOwnershipDTO": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "type": "string"
+                },
+                "rule": {
+                    "$ref": "#/definitions/dto.OwnershipRuleDTO"
+                }
+            }
+        },
+        "dto.GetAbsencesResponse": {
+            "type": "object",
+            "properties": {
+                "absences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AbsenceDTO"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetReviewsResponse": {
+            "type": "object",
+            "properties": {
+                "pull_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PullRequestShortDTO"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GraphQLErrorDTO": {
+            "type": "object",
+            "properties": {
+                "extensions": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "dto.GraphQLRequest": {
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "dto.GraphQLResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GraphQLErrorDTO"
+                    }
+                }
+            }
+        },
+        "dto.MergePRRequest": {
+            "type": "object",
+            "required": [
+                "pull_request_id"
+            ],
+            "properties": {
+                "pull_request_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.NotificationSettingsDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "last_sent_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotificationSettingsResponse": {
+            "type": "object",
+            "properties": {
+                "settings": {
+                    "$ref": "#/definitions/dto.NotificationSettingsDTO"
+                }
+            }
+        },
+        "dto.OwnershipRuleDTO": {
+            "type": "object",
+            "required": [
+                "pattern",
+                "user_ids"
+            ],
+            "properties": {
+                "pattern": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "team_name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "user_ids": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.OwnershipRulesResponse": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OwnershipRuleDTO"
+                    }
+                }
+            }
+        },
+        "dto.PRListResponse": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "pull_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PullRequestDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PRResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/dto.PullRequestDTO"
+                }
+            }
+        },
+        "dto.PairingDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                },
+                "rule": {
+                    "$ref": "#/definitions/dto.PairingRuleDTO"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "dto.ErrorDetail": {
+        "dto.PairingRuleDTO": {
             "type": "object",
             "properties": {
-                "code": {
+                "author_id": {
                     "type": "string"
                 },
-                "message": {
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "dto.ErrorResponse": {
+        "dto.PairingRuleRequest": {
             "type": "object",
+            "required": [
+                "reviewer_id",
+                "type"
+            ],
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/dto.ErrorDetail"
+                "author_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "reviewer_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "type": {
+                    "type": "string",
+                    "maxLength": 32
                 }
             }
         },
-        "dto.GetReviewsResponse": {
+        "dto.PreviewPRRequest": {
             "type": "object",
+            "required": [
+                "author_id",
+                "changed_files",
+                "labels"
+            ],
             "properties": {
-                "pull_requests": {
+                "author_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "changed_files": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.PullRequestShortDTO"
+                        "type": "string"
                     }
                 },
-                "user_id": {
-                    "type": "string"
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "dto.MergePRRequest": {
+        "dto.PreviewPRResponse": {
             "type": "object",
             "properties": {
-                "pull_request_id": {
+                "assigned_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "author_id": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.PRResponse": {
-            "type": "object",
-            "properties": {
-                "pr": {
-                    "$ref": "#/definitions/dto.PullRequestDTO"
+                },
+                "candidates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CandidateDecisionDTO"
+                    }
+                },
+                "fallback_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FallbackReviewerDTO"
+                    }
+                },
+                "seed": {
+                    "type": "string",
+                    "example": "0"
                 }
             }
         },
@@ -420,6 +3110,18 @@ const docTemplate = `{
                 "createdAt": {
                     "type": "string"
                 },
+                "fallback_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FallbackReviewerDTO"
+                    }
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "mergedAt": {
                     "type": "string"
                 },
@@ -429,6 +3131,12 @@ const docTemplate = `{
                 "pull_request_name": {
                     "type": "string"
                 },
+                "stale_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "status": {
                     "type": "string"
                 }
@@ -453,12 +3161,22 @@ const docTemplate = `{
         },
         "dto.ReassignReviewerRequest": {
             "type": "object",
+            "required": [
+                "old_user_id",
+                "pull_request_id"
+            ],
             "properties": {
+                "new_user_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
                 "old_user_id": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "pull_request_id": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
@@ -473,42 +3191,346 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.ReassignToRequest": {
+            "type": "object",
+            "properties": {
+                "new_user_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ReviewerChangeRequest": {
+            "type": "object",
+            "required": [
+                "pull_request_id",
+                "user_id"
+            ],
+            "properties": {
+                "pull_request_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "user_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SLAPolicyDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "review_sla": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SeniorityRuleDTO": {
+            "type": "object",
+            "properties": {
+                "min_level": {
+                    "type": "string"
+                },
+                "min_reviewers": {
+                    "type": "integer"
+                }
+            }
+        },
         "dto.SetActiveRequest": {
             "type": "object",
+            "required": [
+                "user_id"
+            ],
             "properties": {
                 "is_active": {
                     "type": "boolean"
                 },
+                "on_deactivate": {
+                    "description": "OnDeactivate — KEEP (по умолчанию) или REASSIGN",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "on_reactivate": {
+                    "description": "OnReactivate — NONE (по умолчанию) или BACKFILL",
+                    "type": "string",
+                    "maxLength": 32
+                },
                 "user_id": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SetActiveResponse": {
+            "type": "object",
+            "properties": {
+                "affected_prs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AffectedPRDTO"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserDTO"
+                }
+            }
+        },
+        "dto.SetFallbackTeamsRequest": {
+            "type": "object",
+            "required": [
+                "fallback_teams",
+                "team_name"
+            ],
+            "properties": {
+                "fallback_teams": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "team_name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SetNotificationsRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "frequency": {
+                    "type": "string",
+                    "maxLength": 16
+                },
+                "user_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SetOwnershipRulesRequest": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OwnershipRuleDTO"
+                    }
+                }
+            }
+        },
+        "dto.SetPairingRulesRequest": {
+            "type": "object",
+            "required": [
+                "team_name"
+            ],
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PairingRuleRequest"
+                    }
+                },
+                "team_name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SetReviewersTargetRequest": {
+            "type": "object",
+            "required": [
+                "team_name"
+            ],
+            "properties": {
+                "reviewers_target": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 0
+                },
+                "team_name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SetSLAPolicyRequest": {
+            "type": "object",
+            "required": [
+                "team_name"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "review_sla": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "team_name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SetScheduleRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "time_zone": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "user_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "work_end": {
+                    "type": "string",
+                    "maxLength": 5
+                },
+                "work_start": {
+                    "type": "string",
+                    "maxLength": 5
+                }
+            }
+        },
+        "dto.SetSeniorityRequest": {
+            "type": "object",
+            "required": [
+                "seniority",
+                "user_id"
+            ],
+            "properties": {
+                "seniority": {
+                    "type": "string",
+                    "maxLength": 16
+                },
+                "user_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SetSeniorityRuleRequest": {
+            "type": "object",
+            "required": [
+                "team_name"
+            ],
+            "properties": {
+                "min_level": {
+                    "type": "string",
+                    "maxLength": 16
+                },
+                "min_reviewers": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "team_name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.SetTagsRequest": {
+            "type": "object",
+            "required": [
+                "tags",
+                "user_id"
+            ],
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
         "dto.TeamDTO": {
             "type": "object",
             "properties": {
+                "fallback_teams": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "members": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/dto.TeamMemberDTO"
                     }
                 },
+                "pairing_rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PairingRuleDTO"
+                    }
+                },
+                "reviewers_target": {
+                    "type": "integer"
+                },
+                "seniority_rule": {
+                    "$ref": "#/definitions/dto.SeniorityRuleDTO"
+                },
+                "sla": {
+                    "$ref": "#/definitions/dto.SLAPolicyDTO"
+                },
                 "team_name": {
                     "type": "string"
                 }
             }
         },
+        "dto.TeamListResponse": {
+            "type": "object",
+            "properties": {
+                "teams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TeamDTO"
+                    }
+                }
+            }
+        },
         "dto.TeamMemberDTO": {
             "type": "object",
+            "required": [
+                "user_id",
+                "username"
+            ],
             "properties": {
                 "is_active": {
                     "type": "boolean"
                 },
+                "seniority": {
+                    "type": "string",
+                    "maxLength": 16
+                },
                 "user_id": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "username": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
@@ -520,20 +3542,52 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "on_deactivate": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "on_reactivate": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "seniority": {
+                    "type": "string",
+                    "maxLength": 16
+                }
+            }
+        },
         "dto.UserDTO": {
             "type": "object",
             "properties": {
                 "is_active": {
                     "type": "boolean"
                 },
+                "seniority": {
+                    "type": "string"
+                },
                 "team_name": {
                     "type": "string"
                 },
+                "time_zone": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "string"
                 },
                 "username": {
                     "type": "string"
+                },
+                "work_end": {
+                    "type": "string"
+                },
+                "work_start": {
+                    "type": "string"
                 }
             }
         },
@@ -544,6 +3598,20 @@ const docTemplate = `{
                     "$ref": "#/definitions/dto.UserDTO"
                 }
             }
+        },
+        "dto.UserTagsResponse": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 type Config struct {
@@ -13,6 +14,35 @@ type Config struct {
 	DBPassword string
 	DBName     string
 	ServerPort int
+	GRPCPort   int
+
+	AbsenceJobInterval    time.Duration
+	EscalationJobInterval time.Duration
+	ReminderJobInterval   time.Duration
+	BackfillJobInterval   time.Duration
+	ReviewSLA             time.Duration
+	EscalationAction      string
+
+	// IdempotencyKeyTTL — сколько хранится ответ на запрос с Idempotency-Key
+	IdempotencyKeyTTL           time.Duration
+	IdempotencyPurgeJobInterval time.Duration
+
+	// Notifier — способ доставки дайджестов: log, smtp или webhook
+	Notifier     string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+	WebhookURL   string
+
+	// FairnessWindow — число последних назначений команды, в пределах которого
+	// нагрузка ревьюеров различается не больше чем на FairnessMaxSkew; 0 — без ротации
+	FairnessWindow  int
+	FairnessMaxSkew int
+
+	// RandomSeed фиксирует последовательность случайных назначений; 0 — seed от времени
+	RandomSeed int64
 }
 
 func Load() (*Config, error) {
@@ -23,6 +53,30 @@ func Load() (*Config, error) {
 		DBPassword: getEnv("DB_PASSWORD", "postgres"),
 		DBName:     getEnv("DB_NAME", "pr_reviewer_db"),
 		ServerPort: getEnvAsInt("SERVER_PORT", 8080),
+		GRPCPort:   getEnvAsInt("GRPC_PORT", 9090),
+
+		AbsenceJobInterval:    getEnvAsDuration("ABSENCE_JOB_INTERVAL", time.Hour),
+		EscalationJobInterval: getEnvAsDuration("ESCALATION_JOB_INTERVAL", 15*time.Minute),
+		ReminderJobInterval:   getEnvAsDuration("REMINDER_JOB_INTERVAL", time.Hour),
+		BackfillJobInterval:   getEnvAsDuration("BACKFILL_JOB_INTERVAL", 0),
+		ReviewSLA:             getEnvAsDuration("REVIEW_SLA", 24*time.Hour),
+		EscalationAction:      getEnv("ESCALATION_ACTION", "NOTIFY"),
+
+		IdempotencyKeyTTL:           getEnvAsDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour),
+		IdempotencyPurgeJobInterval: getEnvAsDuration("IDEMPOTENCY_PURGE_JOB_INTERVAL", time.Hour),
+
+		Notifier:     getEnv("NOTIFIER", "log"),
+		SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+		SMTPPort:     getEnvAsInt("SMTP_PORT", 25),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		SMTPFrom:     getEnv("SMTP_FROM", "pr-reviewer@localhost"),
+		WebhookURL:   getEnv("WEBHOOK_URL", ""),
+
+		FairnessWindow:  getEnvAsInt("FAIRNESS_WINDOW", 0),
+		FairnessMaxSkew: getEnvAsInt("FAIRNESS_MAX_SKEW", 2),
+
+		RandomSeed: getEnvAsInt64("RANDOM_SEED", 0),
 	}
 
 	return cfg, nil
@@ -48,3 +102,21 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvAsInt64(key string, defaultValue int64) int64 {
+	if value := os.Getenv(key); value != "" {
+		if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return intValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
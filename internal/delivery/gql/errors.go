@@ -0,0 +1,41 @@
+package gql
+
+import (
+	"errors"
+	"log"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+// resolverError — ошибка поля с кодом в extensions.code, по которому клиент
+// различает ошибки так же, как по error.code в REST API. Подробности доменной
+// ошибки отдаются в extensions.details.
+type resolverError struct {
+	code    string
+	message string
+	details domain.Details
+}
+
+func (e *resolverError) Error() string {
+	return e.message
+}
+
+func (e *resolverError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{"code": e.code}
+	if len(e.details) > 0 {
+		extensions["details"] = map[string]interface{}(e.details)
+	}
+	return extensions
+}
+
+// toError отдаёт доменные ошибки с их кодом, а остальные — как INTERNAL_ERROR
+// без текста, записывая исходную ошибку в лог.
+func toError(err error) error {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		return &resolverError{code: domainErr.Code, message: domainErr.Message, details: domainErr.Details}
+	}
+
+	log.Printf("graphql: %v", err)
+	return &resolverError{code: "INTERNAL_ERROR", message: "internal server error"}
+}
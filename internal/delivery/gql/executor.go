@@ -0,0 +1,105 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/team"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/user"
+)
+
+// Executor выполняет GraphQL-запросы поверх use cases. Связанные сущности
+// (ревью пользователей, авторы и ревьюеры PR) загружаются через Loader,
+// поэтому каждый уровень запроса обходится одним обращением к репозиторию.
+type Executor struct {
+	schema graphql.Schema
+
+	createTeamUseCase       *team.CreateTeamUseCase
+	getTeamUseCase          *team.GetTeamUseCase
+	getUsersUseCase         *user.GetUsersUseCase
+	getReviewsBatchUseCase  *user.GetReviewsBatchUseCase
+	setActiveUseCase        *user.SetActiveUseCase
+	getPRsUseCase           *pr.GetPRsUseCase
+	createPRUseCase         *pr.CreatePRUseCase
+	mergePRUseCase          *pr.MergePRUseCase
+	reassignReviewerUseCase *pr.ReassignReviewerUseCase
+	addReviewerUseCase      *pr.AddReviewerUseCase
+	removeReviewerUseCase   *pr.RemoveReviewerUseCase
+}
+
+func NewExecutor(
+	createTeamUseCase *team.CreateTeamUseCase,
+	getTeamUseCase *team.GetTeamUseCase,
+	getUsersUseCase *user.GetUsersUseCase,
+	getReviewsBatchUseCase *user.GetReviewsBatchUseCase,
+	setActiveUseCase *user.SetActiveUseCase,
+	getPRsUseCase *pr.GetPRsUseCase,
+	createPRUseCase *pr.CreatePRUseCase,
+	mergePRUseCase *pr.MergePRUseCase,
+	reassignReviewerUseCase *pr.ReassignReviewerUseCase,
+	addReviewerUseCase *pr.AddReviewerUseCase,
+	removeReviewerUseCase *pr.RemoveReviewerUseCase,
+) (*Executor, error) {
+	e := &Executor{
+		createTeamUseCase:       createTeamUseCase,
+		getTeamUseCase:          getTeamUseCase,
+		getUsersUseCase:         getUsersUseCase,
+		getReviewsBatchUseCase:  getReviewsBatchUseCase,
+		setActiveUseCase:        setActiveUseCase,
+		getPRsUseCase:           getPRsUseCase,
+		createPRUseCase:         createPRUseCase,
+		mergePRUseCase:          mergePRUseCase,
+		reassignReviewerUseCase: reassignReviewerUseCase,
+		addReviewerUseCase:      addReviewerUseCase,
+		removeReviewerUseCase:   removeReviewerUseCase,
+	}
+
+	schema, err := e.buildSchema()
+	if err != nil {
+		return nil, err
+	}
+	e.schema = schema
+
+	return e, nil
+}
+
+type Request struct {
+	Query         string
+	OperationName string
+	Variables     map[string]interface{}
+}
+
+// Execute выполняет запрос с собственным набором Loader: кеш загрузок живёт
+// только в пределах одного запроса.
+func (e *Executor) Execute(ctx context.Context, req Request) *graphql.Result {
+	return graphql.Do(graphql.Params{
+		Schema:         e.schema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        context.WithValue(ctx, loadersKey{}, e.newLoaders()),
+	})
+}
+
+type loaders struct {
+	users   *Loader[string, *domain.User]
+	reviews *Loader[string, []*domain.PullRequest]
+	prs     *Loader[string, *domain.PullRequest]
+}
+
+type loadersKey struct{}
+
+func (e *Executor) newLoaders() *loaders {
+	return &loaders{
+		users:   NewLoader(e.getUsersUseCase.Execute),
+		reviews: NewLoader(e.getReviewsBatchUseCase.Execute),
+		prs:     NewLoader(e.getPRsUseCase.Execute),
+	}
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,98 @@
+package gql
+
+import "sync"
+
+// Loader откладывает загрузку по ключу до первого обращения к результату.
+// Ключи, запрошенные на одном уровне запроса, накапливаются и загружаются
+// одним вызовом batch, а результаты кешируются до конца запроса.
+type Loader[K comparable, V any] struct {
+	mu      sync.Mutex
+	batch   func(keys []K) (map[K]V, error)
+	pending []K
+	entries map[K]*loaderEntry[V]
+}
+
+type loaderEntry[V any] struct {
+	done  bool
+	found bool
+	value V
+	err   error
+}
+
+func NewLoader[K comparable, V any](batch func(keys []K) (map[K]V, error)) *Loader[K, V] {
+	return &Loader[K, V]{
+		batch:   batch,
+		entries: make(map[K]*loaderEntry[V]),
+	}
+}
+
+// Load регистрирует ключ и возвращает функцию, которая при первом вызове
+// загружает все накопленные ключи. Отсутствующему ключу соответствует нулевое
+// значение V.
+func (l *Loader[K, V]) Load(key K) func() (V, error) {
+	entry := l.register(key)
+
+	return func() (V, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if !entry.done {
+			l.dispatch()
+		}
+		return entry.value, entry.err
+	}
+}
+
+// LoadMany — Load для нескольких ключей; отсутствующие ключи пропускаются.
+func (l *Loader[K, V]) LoadMany(keys []K) func() ([]V, error) {
+	entries := make([]*loaderEntry[V], 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, l.register(key))
+	}
+
+	return func() ([]V, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		values := make([]V, 0, len(entries))
+		for _, entry := range entries {
+			if !entry.done {
+				l.dispatch()
+			}
+			if entry.err != nil {
+				return nil, entry.err
+			}
+			if entry.found {
+				values = append(values, entry.value)
+			}
+		}
+		return values, nil
+	}
+}
+
+func (l *Loader[K, V]) register(key K) *loaderEntry[V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.entries[key]
+	if !ok {
+		entry = &loaderEntry[V]{}
+		l.entries[key] = entry
+		l.pending = append(l.pending, key)
+	}
+	return entry
+}
+
+// dispatch загружает все накопленные ключи. Вызывается под l.mu.
+func (l *Loader[K, V]) dispatch() {
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.batch(keys)
+	for _, key := range keys {
+		entry := l.entries[key]
+		entry.value, entry.found = values[key]
+		entry.err = err
+		entry.done = true
+	}
+}
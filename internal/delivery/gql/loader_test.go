@@ -0,0 +1,73 @@
+package gql_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/gql"
+)
+
+func TestGraphQLLoader(t *testing.T) {
+	// Тест проверяет, что ключи, запрошенные до первого обращения к результату, загружаются одним вызовом
+	// Ожидается: один вызов batch с уникальными ключами, повторный запрос берётся из кеша
+	t.Run("Batches and caches keys", func(t *testing.T) {
+		var calls [][]string
+		loader := gql.NewLoader(func(keys []string) (map[string]string, error) {
+			calls = append(calls, keys)
+			values := make(map[string]string)
+			for _, key := range keys {
+				if key != "missing" {
+					values[key] = "value-" + key
+				}
+			}
+			return values, nil
+		})
+
+		first := loader.Load("a")
+		many := loader.LoadMany([]string{"a", "b", "missing"})
+
+		values, err := many()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"value-a", "value-b"}, values)
+
+		tests := []struct {
+			name string
+			load func() (string, error)
+			want string
+		}{
+			{name: "registered before dispatch", load: first, want: "value-a"},
+			{name: "cached", load: loader.Load("b"), want: "value-b"},
+			{name: "missing key", load: loader.Load("missing"), want: ""},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				value, err := tt.load()
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, value)
+			})
+		}
+
+		assert.Equal(t, [][]string{{"a", "b", "missing"}}, calls)
+	})
+
+	// Тест проверяет, что ошибка batch возвращается всем ключам пакета
+	// Ожидается: Load и LoadMany возвращают ошибку загрузки
+	t.Run("Propagates batch error", func(t *testing.T) {
+		errBatch := errors.New("batch failed")
+		loader := gql.NewLoader(func(keys []string) (map[string]string, error) {
+			return nil, errBatch
+		})
+
+		single := loader.Load("a")
+		many := loader.LoadMany([]string{"a", "b"})
+
+		_, err := single()
+		assert.ErrorIs(t, err, errBatch)
+		_, err = many()
+		assert.ErrorIs(t, err, errBatch)
+	})
+}
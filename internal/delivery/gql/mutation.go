@@ -0,0 +1,139 @@
+package gql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/team"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/user"
+)
+
+func (e *Executor) createTeam(p graphql.ResolveParams) (interface{}, error) {
+	var members []team.TeamMemberRequest
+	for _, item := range p.Args["members"].([]interface{}) {
+		member := item.(map[string]interface{})
+		members = append(members, team.TeamMemberRequest{
+			UserID:    member["user_id"].(string),
+			Username:  member["username"].(string),
+			IsActive:  member["is_active"].(bool),
+			Seniority: optionalString(member, "seniority"),
+		})
+	}
+
+	created, err := e.createTeamUseCase.Execute(team.CreateTeamRequest{
+		TeamName: p.Args["team_name"].(string),
+		Members:  members,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return created, nil
+}
+
+func (e *Executor) setIsActive(p graphql.ResolveParams) (interface{}, error) {
+	response, err := e.setActiveUseCase.Execute(user.SetActiveRequest{
+		UserID:       p.Args["user_id"].(string),
+		IsActive:     p.Args["is_active"].(bool),
+		OnDeactivate: optionalString(p.Args, "on_deactivate"),
+		OnReactivate: optionalString(p.Args, "on_reactivate"),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	affected := make([]map[string]interface{}, 0, len(response.AffectedPRs))
+	for _, pr := range response.AffectedPRs {
+		item := map[string]interface{}{
+			"pull_request_id": pr.PRID,
+			"action":          pr.Action,
+			"added_reviewers": nonNil(pr.AddedReviewers),
+		}
+		if pr.ReplacedBy != "" {
+			item["replaced_by"] = pr.ReplacedBy
+		}
+		if pr.Err != nil {
+			item["error"] = domain.CodeOf(pr.Err)
+		}
+		affected = append(affected, item)
+	}
+
+	return map[string]interface{}{
+		"user":                   response.User,
+		"affected_pull_requests": affected,
+	}, nil
+}
+
+func (e *Executor) createPullRequest(p graphql.ResolveParams) (interface{}, error) {
+	created, err := e.createPRUseCase.Execute(pr.CreatePRRequest{
+		PRID:         p.Args["pull_request_id"].(string),
+		PRName:       p.Args["pull_request_name"].(string),
+		AuthorID:     p.Args["author_id"].(string),
+		ChangedFiles: optionalStrings(p.Args, "changed_files"),
+		Labels:       optionalStrings(p.Args, "labels"),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return created, nil
+}
+
+func (e *Executor) mergePullRequest(p graphql.ResolveParams) (interface{}, error) {
+	merged, err := e.mergePRUseCase.Execute(pr.MergePRRequest{PRID: p.Args["pull_request_id"].(string)})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return merged, nil
+}
+
+func (e *Executor) reassignReviewer(p graphql.ResolveParams) (interface{}, error) {
+	result, err := e.reassignReviewerUseCase.Execute(pr.ReassignReviewerRequest{
+		PRID:      p.Args["pull_request_id"].(string),
+		OldUserID: p.Args["old_user_id"].(string),
+		NewUserID: optionalString(p.Args, "new_user_id"),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return map[string]interface{}{
+		"pr":          result.PR,
+		"replaced_by": result.ReplacedBy,
+	}, nil
+}
+
+func (e *Executor) addReviewer(p graphql.ResolveParams) (interface{}, error) {
+	updated, err := e.addReviewerUseCase.Execute(pr.AddReviewerRequest{
+		PRID:   p.Args["pull_request_id"].(string),
+		UserID: p.Args["user_id"].(string),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return updated, nil
+}
+
+func (e *Executor) removeReviewer(p graphql.ResolveParams) (interface{}, error) {
+	updated, err := e.removeReviewerUseCase.Execute(pr.RemoveReviewerRequest{
+		PRID:   p.Args["pull_request_id"].(string),
+		UserID: p.Args["user_id"].(string),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return updated, nil
+}
+
+func optionalString(args map[string]interface{}, name string) string {
+	value, _ := args[name].(string)
+	return value
+}
+
+func optionalStrings(args map[string]interface{}, name string) []string {
+	items, _ := args[name].([]interface{})
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		values = append(values, item.(string))
+	}
+	return values
+}
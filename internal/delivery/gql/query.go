@@ -0,0 +1,79 @@
+package gql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func (e *Executor) resolveTeam(p graphql.ResolveParams) (interface{}, error) {
+	team, err := e.getTeamUseCase.Execute(p.Args["team_name"].(string))
+	if err != nil {
+		return nil, toError(err)
+	}
+	return team, nil
+}
+
+func resolveUser(p graphql.ResolveParams) (interface{}, error) {
+	load := loadersFrom(p.Context).users.Load(p.Args["user_id"].(string))
+	return func() (interface{}, error) {
+		user, err := load()
+		if err != nil {
+			return nil, toError(err)
+		}
+		if user == nil {
+			return nil, toError(domain.ErrNotFound)
+		}
+		return user, nil
+	}, nil
+}
+
+func resolvePullRequest(p graphql.ResolveParams) (interface{}, error) {
+	load := loadersFrom(p.Context).prs.Load(p.Args["pull_request_id"].(string))
+	return func() (interface{}, error) {
+		pr, err := load()
+		if err != nil {
+			return nil, toError(err)
+		}
+		if pr == nil {
+			return nil, toError(domain.ErrNotFound)
+		}
+		return pr, nil
+	}, nil
+}
+
+func resolveReviews(p graphql.ResolveParams) (interface{}, error) {
+	load := loadersFrom(p.Context).reviews.Load(p.Source.(*domain.User).UserID)
+	return func() (interface{}, error) {
+		prs, err := load()
+		if err != nil {
+			return nil, toError(err)
+		}
+		if prs == nil {
+			return []*domain.PullRequest{}, nil
+		}
+		return prs, nil
+	}, nil
+}
+
+func resolveAuthor(p graphql.ResolveParams) (interface{}, error) {
+	load := loadersFrom(p.Context).users.Load(p.Source.(*domain.PullRequest).AuthorID)
+	return func() (interface{}, error) {
+		author, err := load()
+		if err != nil {
+			return nil, toError(err)
+		}
+		return author, nil
+	}, nil
+}
+
+func resolveReviewers(p graphql.ResolveParams) (interface{}, error) {
+	load := loadersFrom(p.Context).users.LoadMany(p.Source.(*domain.PullRequest).AssignedReviewers)
+	return func() (interface{}, error) {
+		reviewers, err := load()
+		if err != nil {
+			return nil, toError(err)
+		}
+		return reviewers, nil
+	}, nil
+}
@@ -0,0 +1,245 @@
+package gql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+// field — поле, значение которого вычисляется из источника типа T без
+// обращения к репозиториям.
+func field[T any](fieldType graphql.Output, get func(T) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: fieldType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return get(p.Source.(T)), nil
+		},
+	}
+}
+
+var nonNullString = graphql.NewNonNull(graphql.String)
+
+var stringList = graphql.NewNonNull(graphql.NewList(nonNullString))
+
+func (e *Executor) buildSchema() (graphql.Schema, error) {
+	var userType, pullRequestType *graphql.Object
+
+	userType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"user_id":   field(nonNullString, func(u *domain.User) interface{} { return u.UserID }),
+				"username":  field(nonNullString, func(u *domain.User) interface{} { return u.Username }),
+				"team_name": field(nonNullString, func(u *domain.User) interface{} { return u.TeamName }),
+				"is_active": field(graphql.NewNonNull(graphql.Boolean), func(u *domain.User) interface{} { return u.IsActive }),
+				"seniority": field(graphql.String, func(u *domain.User) interface{} { return string(u.Seniority) }),
+				"tags":      field(stringList, func(u *domain.User) interface{} { return nonNil(u.Tags) }),
+				"reviews": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(pullRequestType))),
+					Description: "PR, где пользователь назначен ревьюером, от новых к старым",
+					Resolve:     resolveReviews,
+				},
+			}
+		}),
+	})
+
+	fallbackReviewerType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "FallbackReviewer",
+		Fields: graphql.Fields{
+			"user_id":   {Type: nonNullString},
+			"team_name": {Type: nonNullString},
+		},
+	})
+
+	pullRequestType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "PullRequest",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"pull_request_id":    field(nonNullString, func(pr *domain.PullRequest) interface{} { return pr.ID }),
+				"pull_request_name":  field(nonNullString, func(pr *domain.PullRequest) interface{} { return pr.Name }),
+				"author_id":          field(nonNullString, func(pr *domain.PullRequest) interface{} { return pr.AuthorID }),
+				"status":             field(nonNullString, func(pr *domain.PullRequest) interface{} { return string(pr.Status) }),
+				"assigned_reviewers": field(stringList, func(pr *domain.PullRequest) interface{} { return nonNil(pr.AssignedReviewers) }),
+				"fallback_reviewers": field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(fallbackReviewerType))), fallbackReviewers),
+				"stale_reviewers":    field(stringList, staleReviewers),
+				"labels":             field(stringList, func(pr *domain.PullRequest) interface{} { return nonNil(pr.Labels) }),
+				"created_at":         field(graphql.NewNonNull(graphql.DateTime), func(pr *domain.PullRequest) interface{} { return pr.CreatedAt }),
+				"merged_at":          field(graphql.DateTime, func(pr *domain.PullRequest) interface{} { return pr.MergedAt }),
+				"author": {
+					Type:    userType,
+					Resolve: resolveAuthor,
+				},
+				"reviewers": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+					Description: "Назначенные ревьюеры в порядке назначения",
+					Resolve:     resolveReviewers,
+				},
+			}
+		}),
+	})
+
+	teamType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Team",
+		Fields: graphql.Fields{
+			"team_name":      field(nonNullString, func(t *domain.Team) interface{} { return t.TeamName }),
+			"fallback_teams": field(stringList, func(t *domain.Team) interface{} { return nonNil(t.FallbackTeams) }),
+			"members": field(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+				func(t *domain.Team) interface{} { return t.Members }),
+		},
+	})
+
+	affectedPRType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AffectedPullRequest",
+		Fields: graphql.Fields{
+			"pull_request_id": {Type: nonNullString},
+			"action":          {Type: nonNullString},
+			"replaced_by":     {Type: graphql.String},
+			"added_reviewers": {Type: stringList},
+			"error":           {Type: graphql.String},
+		},
+	})
+
+	setIsActivePayloadType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SetIsActivePayload",
+		Fields: graphql.Fields{
+			"user":                   {Type: graphql.NewNonNull(userType)},
+			"affected_pull_requests": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(affectedPRType)))},
+		},
+	})
+
+	reassignPayloadType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ReassignReviewerPayload",
+		Fields: graphql.Fields{
+			"pr":          {Type: graphql.NewNonNull(pullRequestType)},
+			"replaced_by": {Type: nonNullString},
+		},
+	})
+
+	teamMemberInputType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "TeamMemberInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"user_id":   {Type: nonNullString},
+			"username":  {Type: nonNullString},
+			"is_active": {Type: graphql.NewNonNull(graphql.Boolean)},
+			"seniority": {Type: graphql.String},
+		},
+	})
+
+	reviewerChangeArgs := graphql.FieldConfigArgument{
+		"pull_request_id": {Type: nonNullString},
+		"user_id":         {Type: nonNullString},
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"team": {
+				Type:    teamType,
+				Args:    graphql.FieldConfigArgument{"team_name": {Type: nonNullString}},
+				Resolve: e.resolveTeam,
+			},
+			"user": {
+				Type:    userType,
+				Args:    graphql.FieldConfigArgument{"user_id": {Type: nonNullString}},
+				Resolve: resolveUser,
+			},
+			"pull_request": {
+				Type:    pullRequestType,
+				Args:    graphql.FieldConfigArgument{"pull_request_id": {Type: nonNullString}},
+				Resolve: resolvePullRequest,
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"create_team": {
+				Type: teamType,
+				Args: graphql.FieldConfigArgument{
+					"team_name": {Type: nonNullString},
+					"members":   {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(teamMemberInputType)))},
+				},
+				Resolve: e.createTeam,
+			},
+			"set_is_active": {
+				Type: setIsActivePayloadType,
+				Args: graphql.FieldConfigArgument{
+					"user_id":       {Type: nonNullString},
+					"is_active":     {Type: graphql.NewNonNull(graphql.Boolean)},
+					"on_deactivate": {Type: graphql.String},
+					"on_reactivate": {Type: graphql.String},
+				},
+				Resolve: e.setIsActive,
+			},
+			"create_pull_request": {
+				Type: pullRequestType,
+				Args: graphql.FieldConfigArgument{
+					"pull_request_id":   {Type: nonNullString},
+					"pull_request_name": {Type: nonNullString},
+					"author_id":         {Type: nonNullString},
+					"changed_files":     {Type: graphql.NewList(nonNullString)},
+					"labels":            {Type: graphql.NewList(nonNullString)},
+				},
+				Resolve: e.createPullRequest,
+			},
+			"merge_pull_request": {
+				Type:    pullRequestType,
+				Args:    graphql.FieldConfigArgument{"pull_request_id": {Type: nonNullString}},
+				Resolve: e.mergePullRequest,
+			},
+			"reassign_reviewer": {
+				Type: reassignPayloadType,
+				Args: graphql.FieldConfigArgument{
+					"pull_request_id": {Type: nonNullString},
+					"old_user_id":     {Type: nonNullString},
+					"new_user_id":     {Type: graphql.String},
+				},
+				Resolve: e.reassignReviewer,
+			},
+			"add_reviewer": {
+				Type:    pullRequestType,
+				Args:    reviewerChangeArgs,
+				Resolve: e.addReviewer,
+			},
+			"remove_reviewer": {
+				Type:    pullRequestType,
+				Args:    reviewerChangeArgs,
+				Resolve: e.removeReviewer,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: mutation,
+	})
+}
+
+func fallbackReviewers(pr *domain.PullRequest) interface{} {
+	reviewers := []map[string]interface{}{}
+	for _, reviewerID := range pr.AssignedReviewers {
+		if teamName := pr.FallbackTeams[reviewerID]; teamName != "" {
+			reviewers = append(reviewers, map[string]interface{}{"user_id": reviewerID, "team_name": teamName})
+		}
+	}
+	return reviewers
+}
+
+func staleReviewers(pr *domain.PullRequest) interface{} {
+	reviewers := []string{}
+	for _, reviewerID := range pr.AssignedReviewers {
+		if _, ok := pr.StaleReviewers[reviewerID]; ok {
+			reviewers = append(reviewers, reviewerID)
+		}
+	}
+	return reviewers
+}
+
+// nonNil заменяет nil на пустой список, чтобы списки в ответе были [] вместо null.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
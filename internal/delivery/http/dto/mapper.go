@@ -1,35 +1,295 @@
 package dto
 
 import (
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/gql"
 	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/absence"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/ownership"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/reminder"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/team"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/user"
 )
 
+const DateLayout = "2006-01-02"
+
 func ToTeamDTO(team *domain.Team) TeamDTO {
 	members := make([]TeamMemberDTO, 0, len(team.Members))
 	for _, member := range team.Members {
 		members = append(members, TeamMemberDTO{
-			UserID:   member.UserID,
-			Username: member.Username,
-			IsActive: member.IsActive,
+			UserID:    member.UserID,
+			Username:  member.Username,
+			IsActive:  member.IsActive,
+			Seniority: string(member.Seniority),
 		})
 	}
-	return TeamDTO{
-		TeamName: team.TeamName,
-		Members:  members,
+	teamDTO := TeamDTO{
+		TeamName:        team.TeamName,
+		Members:         members,
+		ReviewersTarget: team.TargetReviewers(),
+	}
+	if team.SeniorityRule != nil {
+		teamDTO.SeniorityRule = &SeniorityRuleDTO{
+			MinReviewers: team.SeniorityRule.MinReviewers,
+			MinLevel:     string(team.SeniorityRule.MinLevel),
+		}
+	}
+	if team.SLAPolicy != nil {
+		teamDTO.SLA = &SLAPolicyDTO{
+			ReviewSLA: team.SLAPolicy.ReviewSLA.String(),
+			Action:    string(team.SLAPolicy.Action),
+		}
+	}
+	for _, rule := range team.PairingRules {
+		teamDTO.PairingRules = append(teamDTO.PairingRules, ToPairingRuleDTO(rule))
+	}
+	teamDTO.FallbackTeams = team.FallbackTeams
+	return teamDTO
+}
+
+func ToPairingRuleDTO(rule *domain.PairingRule) PairingRuleDTO {
+	return PairingRuleDTO{
+		Type:       string(rule.Type),
+		AuthorID:   rule.AuthorID,
+		ReviewerID: rule.ReviewerID,
+	}
+}
+
+func ToExplainPairingResponse(resp *team.ExplainPairingResponse) ExplainPairingResponse {
+	candidates := make([]PairingDecisionDTO, 0, len(resp.Decisions))
+	for _, decision := range resp.Decisions {
+		candidate := PairingDecisionDTO{
+			UserID:   decision.User.UserID,
+			Username: decision.User.Username,
+			Status:   string(decision.Status),
+			Reason:   decision.Reason,
+		}
+		if decision.Rule != nil {
+			rule := ToPairingRuleDTO(decision.Rule)
+			candidate.Rule = &rule
+		}
+		candidates = append(candidates, candidate)
+	}
+	return ExplainPairingResponse{
+		TeamName:   resp.TeamName,
+		AuthorID:   resp.AuthorID,
+		Candidates: candidates,
 	}
 }
 
 func ToUserDTO(user *domain.User) UserDTO {
-	return UserDTO{
-		UserID:   user.UserID,
-		Username: user.Username,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+	userDTO := UserDTO{
+		UserID:    user.UserID,
+		Username:  user.Username,
+		TeamName:  user.TeamName,
+		IsActive:  user.IsActive,
+		Seniority: string(user.Seniority),
+		TimeZone:  user.TimeZone,
+	}
+	if user.WorkingHours != nil {
+		userDTO.WorkStart = domain.FormatClockTime(user.WorkingHours.Start)
+		userDTO.WorkEnd = domain.FormatClockTime(user.WorkingHours.End)
+	}
+	return userDTO
+}
+
+func ToUserTagsResponse(userID string, tags []string) UserTagsResponse {
+	if tags == nil {
+		tags = []string{}
+	}
+	return UserTagsResponse{
+		UserID: userID,
+		Tags:   tags,
 	}
 }
 
+func ToAbsenceDTO(absence *domain.Absence) AbsenceDTO {
+	return AbsenceDTO{
+		AbsenceID: absence.ID,
+		UserID:    absence.UserID,
+		StartDate: absence.StartDate.Format(DateLayout),
+		EndDate:   absence.EndDate.Format(DateLayout),
+		Reason:    absence.Reason,
+	}
+}
+
+func ToAssignmentRecordDTO(record *domain.AssignmentRecord) AssignmentRecordDTO {
+	return AssignmentRecordDTO{
+		Action:             string(record.Action),
+		ReviewerID:         record.ReviewerID,
+		ReplacedReviewerID: record.ReplacedReviewerID,
+		Seed:               record.Seed,
+		CreatedAt:          record.CreatedAt,
+	}
+}
+
+func ToPreviewPRResponse(authorID string, assignment *domain.Assignment) PreviewPRResponse {
+	reviewerIDs := assignment.ReviewerIDs
+	if reviewerIDs == nil {
+		reviewerIDs = []string{}
+	}
+
+	return PreviewPRResponse{
+		AuthorID:          authorID,
+		AssignedReviewers: reviewerIDs,
+		FallbackReviewers: ToFallbackReviewerDTOs(reviewerIDs, assignment.FallbackTeams),
+		Seed:              assignment.Seed,
+		Candidates:        ToCandidateDecisionDTOs(assignment.Decisions),
+	}
+}
+
+// ToFallbackReviewerDTOs перечисляет ревьюеров из запасных команд в порядке
+// назначения.
+func ToFallbackReviewerDTOs(reviewerIDs []string, fallbackTeams map[string]string) []FallbackReviewerDTO {
+	var result []FallbackReviewerDTO
+	for _, reviewerID := range reviewerIDs {
+		if teamName := fallbackTeams[reviewerID]; teamName != "" {
+			result = append(result, FallbackReviewerDTO{UserID: reviewerID, TeamName: teamName})
+		}
+	}
+	return result
+}
+
+func ToBackfillResponse(report *pr.BackfillReport) BackfillResponse {
+	results := make([]BackfillResultDTO, 0, len(report.Results))
+	for _, result := range report.Results {
+		added := result.AddedReviewers
+		if added == nil {
+			added = []string{}
+		}
+
+		resultDTO := BackfillResultDTO{
+			PRID:              result.PRID,
+			AddedReviewers:    added,
+			FallbackReviewers: ToFallbackReviewerDTOs(added, result.FallbackTeams),
+			Missing:           result.Missing,
+		}
+		if result.Err != nil {
+			resultDTO.Error = domain.CodeOf(result.Err)
+		}
+		results = append(results, resultDTO)
+	}
+
+	return BackfillResponse{
+		Scanned: report.Scanned,
+		Results: results,
+	}
+}
+
+func ToAssignmentReasoningDTO(reasoning *domain.AssignmentReasoning) AssignmentReasoningDTO {
+	return AssignmentReasoningDTO{
+		Action:     string(reasoning.Action),
+		Seed:       reasoning.Seed,
+		CreatedAt:  reasoning.CreatedAt,
+		Candidates: ToCandidateDecisionDTOs(reasoning.Decisions),
+	}
+}
+
+func ToCandidateDecisionDTOs(decisions []domain.CandidateDecision) []CandidateDecisionDTO {
+	result := make([]CandidateDecisionDTO, 0, len(decisions))
+	for _, decision := range decisions {
+		result = append(result, CandidateDecisionDTO{
+			UserID: decision.UserID,
+			Status: string(decision.Status),
+			Reason: decision.Reason,
+			Rank:   decision.Rank,
+		})
+	}
+	return result
+}
+
+func ToEscalationDTO(escalation *domain.Escalation) EscalationDTO {
+	return EscalationDTO{
+		ReviewerID:    escalation.ReviewerID,
+		Action:        string(escalation.Action),
+		NewReviewerID: escalation.NewReviewerID,
+		Details:       escalation.Details,
+		CreatedAt:     escalation.CreatedAt,
+	}
+}
+
+func ToNotificationSettingsDTO(settings *domain.NotificationSettings) NotificationSettingsDTO {
+	return NotificationSettingsDTO{
+		UserID:     settings.UserID,
+		Email:      settings.Email,
+		Frequency:  string(settings.Frequency),
+		LastSentAt: settings.LastSentAt,
+	}
+}
+
+func ToBatchCreatePRsRequest(req BatchCreatePRsRequest) pr.BatchCreatePRsRequest {
+	items := make([]pr.CreatePRRequest, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, pr.CreatePRRequest{
+			PRID:         item.PRID,
+			PRName:       item.PRName,
+			AuthorID:     item.AuthorID,
+			ChangedFiles: item.ChangedFiles,
+			Labels:       item.Labels,
+		})
+	}
+	return pr.BatchCreatePRsRequest{Mode: req.Mode, Items: items}
+}
+
+func ToBatchMergePRsRequest(req BatchMergePRsRequest) pr.BatchMergePRsRequest {
+	items := make([]pr.MergePRRequest, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, pr.MergePRRequest{PRID: item.PRID})
+	}
+	return pr.BatchMergePRsRequest{Mode: req.Mode, Items: items}
+}
+
+func ToBatchReassignReviewersRequest(req BatchReassignReviewersRequest) pr.BatchReassignReviewersRequest {
+	items := make([]pr.ReassignReviewerRequest, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, pr.ReassignReviewerRequest{
+			PRID:      item.PRID,
+			OldUserID: item.OldUserID,
+			NewUserID: item.NewUserID,
+		})
+	}
+	return pr.BatchReassignReviewersRequest{Mode: req.Mode, Items: items}
+}
+
+func ToBatchResponse(result *pr.BatchResult) BatchResponse {
+	response := BatchResponse{
+		Mode:    string(result.Mode),
+		Results: make([]BatchResultDTO, 0, len(result.Items)),
+	}
+	if result.Err != nil {
+		response.Aborted = true
+		response.Error = domain.CodeOf(result.Err)
+	}
+	for _, item := range result.Items {
+		resultDTO := BatchResultDTO{
+			Index:      item.Index,
+			PRID:       item.PRID,
+			Status:     item.Status,
+			ReplacedBy: item.ReplacedBy,
+		}
+		if item.PR != nil {
+			prDTO := ToPullRequestDTO(item.PR)
+			resultDTO.PR = &prDTO
+		}
+		if item.Err != nil {
+			resultDTO.Error = domain.CodeOf(item.Err)
+		}
+
+		switch item.Status {
+		case pr.BatchItemSucceeded:
+			response.Succeeded++
+		case pr.BatchItemFailed:
+			response.Failed++
+		}
+		response.Results = append(response.Results, resultDTO)
+	}
+	return response
+}
+
 func ToPullRequestDTO(pr *domain.PullRequest) PullRequestDTO {
 	return PullRequestDTO{
 		PRID:              pr.ID,
@@ -37,11 +297,25 @@ func ToPullRequestDTO(pr *domain.PullRequest) PullRequestDTO {
 		AuthorID:          pr.AuthorID,
 		Status:            string(pr.Status),
 		AssignedReviewers: pr.AssignedReviewers,
+		FallbackReviewers: ToFallbackReviewerDTOs(pr.AssignedReviewers, pr.FallbackTeams),
+		StaleReviewers:    staleReviewers(pr),
+		Labels:            pr.Labels,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
 	}
 }
 
+// staleReviewers перечисляет ревьюеров с устаревшими ревью в порядке назначения.
+func staleReviewers(pr *domain.PullRequest) []string {
+	var result []string
+	for _, reviewerID := range pr.AssignedReviewers {
+		if _, ok := pr.StaleReviewers[reviewerID]; ok {
+			result = append(result, reviewerID)
+		}
+	}
+	return result
+}
+
 func ToPullRequestShortDTO(pr *domain.PullRequest) PullRequestShortDTO {
 	return PullRequestShortDTO{
 		PRID:     pr.ID,
@@ -55,9 +329,10 @@ func ToCreateTeamRequest(req CreateTeamRequest) team.CreateTeamRequest {
 	members := make([]team.TeamMemberRequest, 0, len(req.Members))
 	for _, member := range req.Members {
 		members = append(members, team.TeamMemberRequest{
-			UserID:   member.UserID,
-			Username: member.Username,
-			IsActive: member.IsActive,
+			UserID:    member.UserID,
+			Username:  member.Username,
+			IsActive:  member.IsActive,
+			Seniority: member.Seniority,
 		})
 	}
 	return team.CreateTeamRequest{
@@ -68,7 +343,246 @@ func ToCreateTeamRequest(req CreateTeamRequest) team.CreateTeamRequest {
 
 func ToSetActiveRequest(req SetActiveRequest) user.SetActiveRequest {
 	return user.SetActiveRequest{
-		UserID:   req.UserID,
-		IsActive: req.IsActive,
+		UserID:       req.UserID,
+		IsActive:     req.IsActive,
+		OnDeactivate: req.OnDeactivate,
+		OnReactivate: req.OnReactivate,
+	}
+}
+
+func ToSetActiveResponse(response *user.SetActiveResponse) SetActiveResponse {
+	affected := make([]AffectedPRDTO, 0, len(response.AffectedPRs))
+	for _, affectedPR := range response.AffectedPRs {
+		affectedDTO := AffectedPRDTO{
+			PRID:           affectedPR.PRID,
+			Action:         affectedPR.Action,
+			ReplacedBy:     affectedPR.ReplacedBy,
+			AddedReviewers: affectedPR.AddedReviewers,
+		}
+		if affectedPR.Err != nil {
+			affectedDTO.Error = domain.CodeOf(affectedPR.Err)
+		}
+		affected = append(affected, affectedDTO)
+	}
+
+	return SetActiveResponse{
+		User:        ToUserDTO(response.User),
+		AffectedPRs: affected,
+	}
+}
+
+func ToSetSeniorityRuleRequest(req SetSeniorityRuleRequest) team.SetSeniorityRuleRequest {
+	return team.SetSeniorityRuleRequest{
+		TeamName:     req.TeamName,
+		MinReviewers: req.MinReviewers,
+		MinLevel:     req.MinLevel,
+	}
+}
+
+func ToSetSLAPolicyRequest(req SetSLAPolicyRequest) team.SetSLAPolicyRequest {
+	return team.SetSLAPolicyRequest{
+		TeamName:  req.TeamName,
+		ReviewSLA: req.ReviewSLA,
+		Action:    req.Action,
+	}
+}
+
+func ToSetReviewersTargetRequest(req SetReviewersTargetRequest) team.SetReviewersTargetRequest {
+	return team.SetReviewersTargetRequest{
+		TeamName:        req.TeamName,
+		ReviewersTarget: req.ReviewersTarget,
+	}
+}
+
+func ToSetFallbackTeamsRequest(req SetFallbackTeamsRequest) team.SetFallbackTeamsRequest {
+	return team.SetFallbackTeamsRequest{
+		TeamName:      req.TeamName,
+		FallbackTeams: req.FallbackTeams,
+	}
+}
+
+func ToSetPairingRulesRequest(req SetPairingRulesRequest) team.SetPairingRulesRequest {
+	rules := make([]team.PairingRuleRequest, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		rules = append(rules, team.PairingRuleRequest{
+			Type:       rule.Type,
+			AuthorID:   rule.AuthorID,
+			ReviewerID: rule.ReviewerID,
+		})
+	}
+	return team.SetPairingRulesRequest{
+		TeamName: req.TeamName,
+		Rules:    rules,
+	}
+}
+
+func ToSetSeniorityRequest(req SetSeniorityRequest) user.SetSeniorityRequest {
+	return user.SetSeniorityRequest{
+		UserID:    req.UserID,
+		Seniority: req.Seniority,
+	}
+}
+
+func ToSetScheduleRequest(req SetScheduleRequest) user.SetScheduleRequest {
+	return user.SetScheduleRequest{
+		UserID:    req.UserID,
+		TimeZone:  req.TimeZone,
+		WorkStart: req.WorkStart,
+		WorkEnd:   req.WorkEnd,
+	}
+}
+
+func ToSetTagsRequest(req SetTagsRequest) user.SetTagsRequest {
+	return user.SetTagsRequest{
+		UserID: req.UserID,
+		Tags:   req.Tags,
+	}
+}
+
+func ToOwnershipRuleDTO(rule *domain.OwnershipRule) OwnershipRuleDTO {
+	return OwnershipRuleDTO{
+		Pattern:  rule.Pattern,
+		TeamName: rule.TeamName,
+		UserIDs:  rule.UserIDs,
+	}
+}
+
+func ToOwnershipRulesResponse(rules []*domain.OwnershipRule) OwnershipRulesResponse {
+	ruleDTOs := make([]OwnershipRuleDTO, 0, len(rules))
+	for _, rule := range rules {
+		ruleDTOs = append(ruleDTOs, ToOwnershipRuleDTO(rule))
+	}
+	return OwnershipRulesResponse{
+		Rules: ruleDTOs,
+	}
+}
+
+func ToFileOwnershipDTO(ownership domain.FileOwnership) FileOwnershipDTO {
+	fileDTO := FileOwnershipDTO{
+		File: ownership.File,
+	}
+	if ownership.Rule != nil {
+		rule := ToOwnershipRuleDTO(ownership.Rule)
+		fileDTO.Rule = &rule
+	}
+	return fileDTO
+}
+
+func ToSetOwnershipRulesRequest(req SetOwnershipRulesRequest) ownership.SetRulesRequest {
+	rules := make([]ownership.RuleRequest, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		rules = append(rules, ownership.RuleRequest{
+			Pattern:  rule.Pattern,
+			TeamName: rule.TeamName,
+			UserIDs:  rule.UserIDs,
+		})
+	}
+	return ownership.SetRulesRequest{
+		Rules: rules,
+	}
+}
+
+func ToAddAbsenceRequest(req AddAbsenceRequest) (absence.AddAbsenceRequest, error) {
+	startDate, err := time.Parse(DateLayout, req.StartDate)
+	if err != nil {
+		return absence.AddAbsenceRequest{}, err
+	}
+	endDate, err := time.Parse(DateLayout, req.EndDate)
+	if err != nil {
+		return absence.AddAbsenceRequest{}, err
+	}
+	return absence.AddAbsenceRequest{
+		UserID:    req.UserID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    req.Reason,
+	}, nil
+}
+
+func ToSetNotificationsRequest(req SetNotificationsRequest) reminder.SetSettingsRequest {
+	return reminder.SetSettingsRequest{
+		UserID:    req.UserID,
+		Email:     req.Email,
+		Frequency: req.Frequency,
+	}
+}
+
+func ToTeamListResponse(teams []*domain.Team) TeamListResponse {
+	response := TeamListResponse{Teams: make([]TeamDTO, 0, len(teams))}
+	for _, team := range teams {
+		response.Teams = append(response.Teams, ToTeamDTO(team))
+	}
+	return response
+}
+
+func ToUpdateUserRequest(userID string, req UpdateUserRequest) user.UpdateUserRequest {
+	return user.UpdateUserRequest{
+		UserID:       userID,
+		IsActive:     req.IsActive,
+		OnDeactivate: req.OnDeactivate,
+		OnReactivate: req.OnReactivate,
+		Seniority:    req.Seniority,
+	}
+}
+
+// ToListPRsRequest разбирает даты фильтра; created_to включает весь день,
+// поэтому в use case передаётся начало следующего.
+func ToListPRsRequest(query ListPRsQuery) (pr.ListPRsRequest, error) {
+	req := pr.ListPRsRequest{
+		AuthorID:   query.AuthorID,
+		TeamName:   query.TeamName,
+		ReviewerID: query.ReviewerID,
+		Status:     query.Status,
+		Limit:      query.Limit,
+		Offset:     query.Offset,
+	}
+	if query.CreatedFrom != "" {
+		createdFrom, err := time.Parse(DateLayout, query.CreatedFrom)
+		if err != nil {
+			return pr.ListPRsRequest{}, err
+		}
+		req.CreatedFrom = &createdFrom
+	}
+	if query.CreatedTo != "" {
+		createdTo, err := time.Parse(DateLayout, query.CreatedTo)
+		if err != nil {
+			return pr.ListPRsRequest{}, err
+		}
+		createdTo = createdTo.AddDate(0, 0, 1)
+		req.CreatedTo = &createdTo
+	}
+	return req, nil
+}
+
+func ToPRListResponse(result *pr.ListPRsResponse) PRListResponse {
+	response := PRListResponse{
+		PullRequests: make([]PullRequestDTO, 0, len(result.PullRequests)),
+		Total:        result.Total,
+		Limit:        result.Limit,
+		Offset:       result.Offset,
+	}
+	for _, pullRequest := range result.PullRequests {
+		response.PullRequests = append(response.PullRequests, ToPullRequestDTO(pullRequest))
+	}
+	return response
+}
+
+func ToGraphQLRequest(req GraphQLRequest) gql.Request {
+	return gql.Request{
+		Query:         req.Query,
+		OperationName: req.OperationName,
+		Variables:     req.Variables,
+	}
+}
+
+func ToGraphQLResponse(result *graphql.Result) GraphQLResponse {
+	response := GraphQLResponse{Data: result.Data}
+	for _, err := range result.Errors {
+		response.Errors = append(response.Errors, GraphQLErrorDTO{
+			Message:    err.Message,
+			Path:       err.Path,
+			Extensions: err.Extensions,
+		})
 	}
+	return response
 }
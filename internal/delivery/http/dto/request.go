@@ -1,32 +1,206 @@
 package dto
 
 type CreateTeamRequest struct {
-	TeamName string          `json:"team_name"`
-	Members  []TeamMemberDTO `json:"members"`
+	TeamName string          `json:"team_name" binding:"required,max=255,id"`
+	Members  []TeamMemberDTO `json:"members" binding:"unique=UserID,dive"`
 }
 
 type TeamMemberDTO struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
+	UserID    string `json:"user_id" binding:"required,max=255,id"`
+	Username  string `json:"username" binding:"required,max=255"`
+	IsActive  bool   `json:"is_active"`
+	Seniority string `json:"seniority,omitempty" binding:"max=16"`
+}
+
+type SetSeniorityRuleRequest struct {
+	TeamName     string `json:"team_name" binding:"required,max=255,id"`
+	MinReviewers int    `json:"min_reviewers" binding:"min=0"`
+	MinLevel     string `json:"min_level" binding:"max=16"`
+}
+
+type SetSLAPolicyRequest struct {
+	TeamName  string `json:"team_name" binding:"required,max=255,id"`
+	ReviewSLA string `json:"review_sla" binding:"max=64"`
+	Action    string `json:"action" binding:"max=32"`
+}
+
+// SetReviewersTargetRequest — ReviewersTarget 0 возвращает цель по умолчанию.
+type SetReviewersTargetRequest struct {
+	TeamName        string `json:"team_name" binding:"required,max=255,id"`
+	ReviewersTarget int    `json:"reviewers_target" binding:"min=0,max=10"`
+}
+
+type SetFallbackTeamsRequest struct {
+	TeamName      string   `json:"team_name" binding:"required,max=255,id"`
+	FallbackTeams []string `json:"fallback_teams" binding:"unique,dive,required,max=255,id"`
+}
+
+type SetPairingRulesRequest struct {
+	TeamName string               `json:"team_name" binding:"required,max=255,id"`
+	Rules    []PairingRuleRequest `json:"rules" binding:"dive"`
+}
+
+type PairingRuleRequest struct {
+	Type       string `json:"type" binding:"required,max=32"`
+	AuthorID   string `json:"author_id,omitempty" binding:"omitempty,max=255,id"`
+	ReviewerID string `json:"reviewer_id" binding:"required,max=255,id"`
+}
+
+type ExplainPairingRequest struct {
+	AuthorID string `json:"author_id" binding:"required,max=255,id"`
 }
 
 type SetActiveRequest struct {
-	UserID   string `json:"user_id"`
+	UserID   string `json:"user_id" binding:"required,max=255,id"`
 	IsActive bool   `json:"is_active"`
+	// OnDeactivate — KEEP (по умолчанию) или REASSIGN
+	OnDeactivate string `json:"on_deactivate,omitempty" binding:"max=32"`
+	// OnReactivate — NONE (по умолчанию) или BACKFILL
+	OnReactivate string `json:"on_reactivate,omitempty" binding:"max=32"`
+}
+
+type SetSeniorityRequest struct {
+	UserID    string `json:"user_id" binding:"required,max=255,id"`
+	Seniority string `json:"seniority" binding:"required,max=16"`
+}
+
+type SetScheduleRequest struct {
+	UserID    string `json:"user_id" binding:"required,max=255,id"`
+	TimeZone  string `json:"time_zone" binding:"max=64"`
+	WorkStart string `json:"work_start" binding:"max=5"`
+	WorkEnd   string `json:"work_end" binding:"max=5"`
+}
+
+type SetTagsRequest struct {
+	UserID string   `json:"user_id" binding:"required,max=255,id"`
+	Tags   []string `json:"tags" binding:"dive,required,max=64"`
+}
+
+type AddAbsenceRequest struct {
+	UserID    string `json:"user_id" binding:"required,max=255,id"`
+	StartDate string `json:"start_date" binding:"required"`
+	EndDate   string `json:"end_date" binding:"required"`
+	Reason    string `json:"reason" binding:"max=255"`
+}
+
+type DeleteAbsenceRequest struct {
+	AbsenceID int64 `json:"absence_id" binding:"required,gt=0"`
 }
 
 type CreatePRRequest struct {
-	PRID     string `json:"pull_request_id"`
-	PRName   string `json:"pull_request_name"`
-	AuthorID string `json:"author_id"`
+	PRID         string   `json:"pull_request_id" binding:"required,max=255,id"`
+	PRName       string   `json:"pull_request_name" binding:"required,max=255"`
+	AuthorID     string   `json:"author_id" binding:"required,max=255,id"`
+	ChangedFiles []string `json:"changed_files" binding:"dive,required,max=1024"`
+	Labels       []string `json:"labels" binding:"dive,required,max=64"`
+}
+
+type PreviewPRRequest struct {
+	AuthorID     string   `json:"author_id" binding:"required,max=255,id"`
+	ChangedFiles []string `json:"changed_files" binding:"dive,required,max=1024"`
+	Labels       []string `json:"labels" binding:"dive,required,max=64"`
 }
 
 type MergePRRequest struct {
-	PRID string `json:"pull_request_id"`
+	PRID string `json:"pull_request_id" binding:"required,max=255,id"`
 }
 
 type ReassignReviewerRequest struct {
-	PRID      string `json:"pull_request_id"`
-	OldUserID string `json:"old_user_id"`
+	PRID      string `json:"pull_request_id" binding:"required,max=255,id"`
+	OldUserID string `json:"old_user_id" binding:"required,max=255,id"`
+	NewUserID string `json:"new_user_id,omitempty" binding:"omitempty,max=255,id"`
+}
+
+// Batch*Request — пакетные операции над PR. mode: BEST_EFFORT (по умолчанию)
+// или ALL_OR_NOTHING.
+type BatchCreatePRsRequest struct {
+	Mode  string            `json:"mode" binding:"max=32"`
+	Items []CreatePRRequest `json:"items" binding:"min=1,max=500,dive"`
+}
+
+type BatchMergePRsRequest struct {
+	Mode  string           `json:"mode" binding:"max=32"`
+	Items []MergePRRequest `json:"items" binding:"min=1,max=500,dive"`
+}
+
+type BatchReassignReviewersRequest struct {
+	Mode  string                    `json:"mode" binding:"max=32"`
+	Items []ReassignReviewerRequest `json:"items" binding:"min=1,max=500,dive"`
+}
+
+type ReviewerChangeRequest struct {
+	PRID   string `json:"pull_request_id" binding:"required,max=255,id"`
+	UserID string `json:"user_id" binding:"required,max=255,id"`
+}
+
+type SetOwnershipRulesRequest struct {
+	Rules []OwnershipRuleDTO `json:"rules" binding:"dive"`
+}
+
+type OwnershipRuleDTO struct {
+	Pattern  string   `json:"pattern" binding:"required,max=255"`
+	TeamName string   `json:"team_name,omitempty" binding:"omitempty,max=255,id"`
+	UserIDs  []string `json:"user_ids,omitempty" binding:"unique,dive,required,max=255,id"`
+}
+
+type ExplainOwnershipRequest struct {
+	ChangedFiles []string `json:"changed_files" binding:"dive,required,max=1024"`
+}
+
+type SetNotificationsRequest struct {
+	UserID    string `json:"user_id" binding:"required,max=255,id"`
+	Email     string `json:"email" binding:"omitempty,max=255,email"`
+	Frequency string `json:"frequency" binding:"max=16"`
+}
+
+// UpdateUserRequest — тело PATCH /api/v2/users/{id}; отсутствующие поля не меняются.
+type UpdateUserRequest struct {
+	IsActive     *bool   `json:"is_active,omitempty"`
+	OnDeactivate string  `json:"on_deactivate,omitempty" binding:"max=32"`
+	OnReactivate string  `json:"on_reactivate,omitempty" binding:"max=32"`
+	Seniority    *string `json:"seniority,omitempty" binding:"omitempty,max=16"`
+}
+
+// ReassignToRequest — необязательное тело переназначения в API v2.
+type ReassignToRequest struct {
+	NewUserID string `json:"new_user_id,omitempty" binding:"omitempty,max=255,id"`
+}
+
+// UserPath, TeamPath и PRPath — параметры пути API v2.
+type UserPath struct {
+	UserID string `uri:"user_id" binding:"required,max=255,id"`
+}
+
+type TeamPath struct {
+	TeamName string `uri:"team_name" binding:"required,max=255,id"`
+}
+
+type PRPath struct {
+	PRID string `uri:"pull_request_id" binding:"required,max=255,id"`
+}
+
+// ReviewerPath — PR и ревьюер из POST /pull-requests/{id}/reviewers/{user_id}:{действие}.
+// Ревьюер отделяется от действия в обработчике, поэтому структура заполняется вручную.
+type ReviewerPath struct {
+	PRID       string `uri:"pull_request_id" binding:"required,max=255,id"`
+	ReviewerID string `uri:"reviewer_id" binding:"required,max=255,id"`
+}
+
+type GraphQLRequest struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// ListPRsQuery — параметры GET /pullRequest/list. Даты — в формате
+// DateLayout, created_to включительно.
+type ListPRsQuery struct {
+	AuthorID    string `form:"author_id" binding:"omitempty,max=255,id"`
+	TeamName    string `form:"team_name" binding:"omitempty,max=255,id"`
+	ReviewerID  string `form:"reviewer_id" binding:"omitempty,max=255,id"`
+	Status      string `form:"status" binding:"max=16"`
+	CreatedFrom string `form:"created_from"`
+	CreatedTo   string `form:"created_to"`
+	Limit       int    `form:"limit"`
+	Offset      int    `form:"offset"`
 }
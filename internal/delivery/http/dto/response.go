@@ -6,34 +6,232 @@ type TeamResponse struct {
 	Team TeamDTO `json:"team"`
 }
 
+type TeamListResponse struct {
+	Teams []TeamDTO `json:"teams"`
+}
+
+// TeamDTO — ReviewersTarget — действующая цель по числу ревьюеров на PR.
 type TeamDTO struct {
-	TeamName string          `json:"team_name"`
-	Members  []TeamMemberDTO `json:"members"`
+	TeamName        string            `json:"team_name"`
+	Members         []TeamMemberDTO   `json:"members"`
+	ReviewersTarget int               `json:"reviewers_target"`
+	SeniorityRule   *SeniorityRuleDTO `json:"seniority_rule,omitempty"`
+	SLA             *SLAPolicyDTO     `json:"sla,omitempty"`
+	PairingRules    []PairingRuleDTO  `json:"pairing_rules,omitempty"`
+	FallbackTeams   []string          `json:"fallback_teams,omitempty"`
+}
+
+type PairingRuleDTO struct {
+	Type       string `json:"type"`
+	AuthorID   string `json:"author_id,omitempty"`
+	ReviewerID string `json:"reviewer_id"`
+}
+
+type ExplainPairingResponse struct {
+	TeamName   string               `json:"team_name"`
+	AuthorID   string               `json:"author_id"`
+	Candidates []PairingDecisionDTO `json:"candidates"`
+}
+
+type PairingDecisionDTO struct {
+	UserID   string          `json:"user_id"`
+	Username string          `json:"username"`
+	Status   string          `json:"status"`
+	Reason   string          `json:"reason,omitempty"`
+	Rule     *PairingRuleDTO `json:"rule,omitempty"`
+}
+
+type SLAPolicyDTO struct {
+	ReviewSLA string `json:"review_sla"`
+	Action    string `json:"action"`
+}
+
+type SeniorityRuleDTO struct {
+	MinReviewers int    `json:"min_reviewers"`
+	MinLevel     string `json:"min_level"`
 }
 
 type UserResponse struct {
 	User UserDTO `json:"user"`
 }
 
+type SetActiveResponse struct {
+	User        UserDTO         `json:"user"`
+	AffectedPRs []AffectedPRDTO `json:"affected_prs"`
+}
+
+// AffectedPRDTO — изменение открытого PR при смене активности ревьюера.
+type AffectedPRDTO struct {
+	PRID           string   `json:"pull_request_id"`
+	Action         string   `json:"action"`
+	ReplacedBy     string   `json:"replaced_by,omitempty"`
+	AddedReviewers []string `json:"added_reviewers,omitempty"`
+	Error          string   `json:"error,omitempty"`
+}
+
 type UserDTO struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	TeamName  string `json:"team_name"`
+	IsActive  bool   `json:"is_active"`
+	Seniority string `json:"seniority"`
+	TimeZone  string `json:"time_zone"`
+	WorkStart string `json:"work_start,omitempty"`
+	WorkEnd   string `json:"work_end,omitempty"`
+}
+
+type UserTagsResponse struct {
+	UserID string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+}
+
+type AbsenceResponse struct {
+	Absence AbsenceDTO `json:"absence"`
+}
+
+type GetAbsencesResponse struct {
+	UserID   string       `json:"user_id"`
+	Absences []AbsenceDTO `json:"absences"`
+}
+
+type AbsenceDTO struct {
+	AbsenceID int64  `json:"absence_id"`
+	UserID    string `json:"user_id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Reason    string `json:"reason"`
+}
+
+type AssignmentHistoryResponse struct {
+	PRID    string                `json:"pull_request_id"`
+	History []AssignmentRecordDTO `json:"history"`
+}
+
+type AssignmentRecordDTO struct {
+	Action             string    `json:"action"`
+	ReviewerID         string    `json:"reviewer_id"`
+	ReplacedReviewerID string    `json:"replaced_reviewer_id,omitempty"`
+	Seed               int64     `json:"seed,string"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type PreviewPRResponse struct {
+	AuthorID          string                 `json:"author_id"`
+	AssignedReviewers []string               `json:"assigned_reviewers"`
+	FallbackReviewers []FallbackReviewerDTO  `json:"fallback_reviewers,omitempty"`
+	Seed              int64                  `json:"seed,string"`
+	Candidates        []CandidateDecisionDTO `json:"candidates"`
+}
+
+type BackfillResponse struct {
+	Scanned int                 `json:"scanned"`
+	Results []BackfillResultDTO `json:"results"`
+}
+
+// BackfillResultDTO — изменения одного PR, которому не хватало ревьюеров.
+type BackfillResultDTO struct {
+	PRID              string                `json:"pull_request_id"`
+	AddedReviewers    []string              `json:"added_reviewers"`
+	FallbackReviewers []FallbackReviewerDTO `json:"fallback_reviewers,omitempty"`
+	Missing           int                   `json:"missing"`
+	Error             string                `json:"error,omitempty"`
+}
+
+// BatchResponse — итог пакетной операции; results идут в порядке элементов
+// запроса.
+// BatchResponse — итог пакета. Aborted — пакет остановлен ошибкой, не
+// относящейся к элементу; её код — в error.
+type BatchResponse struct {
+	Mode      string           `json:"mode"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Aborted   bool             `json:"aborted"`
+	Error     string           `json:"error,omitempty"`
+	Results   []BatchResultDTO `json:"results"`
+}
+
+// BatchResultDTO — итог элемента: SUCCEEDED, FAILED с доменным кодом в error,
+// ROLLED_BACK или SKIPPED, если пакет ALL_OR_NOTHING откатился.
+type BatchResultDTO struct {
+	Index      int             `json:"index"`
+	PRID       string          `json:"pull_request_id"`
+	Status     string          `json:"status"`
+	PR         *PullRequestDTO `json:"pr,omitempty"`
+	ReplacedBy string          `json:"replaced_by,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+type AssignmentReasoningResponse struct {
+	PRID      string                   `json:"pull_request_id"`
+	Reasoning []AssignmentReasoningDTO `json:"reasoning"`
+}
+
+type AssignmentReasoningDTO struct {
+	Action     string                 `json:"action"`
+	Seed       int64                  `json:"seed,string"`
+	CreatedAt  time.Time              `json:"created_at"`
+	Candidates []CandidateDecisionDTO `json:"candidates"`
+}
+
+type CandidateDecisionDTO struct {
+	UserID string `json:"user_id"`
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+	Rank   int    `json:"rank,omitempty"`
+}
+
+type EscalationsResponse struct {
+	PRID        string          `json:"pull_request_id"`
+	Escalations []EscalationDTO `json:"escalations"`
+}
+
+type EscalationDTO struct {
+	ReviewerID    string    `json:"reviewer_id"`
+	Action        string    `json:"action"`
+	NewReviewerID string    `json:"new_reviewer_id,omitempty"`
+	Details       string    `json:"details,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type NotificationSettingsResponse struct {
+	Settings NotificationSettingsDTO `json:"settings"`
+}
+
+type NotificationSettingsDTO struct {
+	UserID     string     `json:"user_id"`
+	Email      string     `json:"email"`
+	Frequency  string     `json:"frequency"`
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
 }
 
 type PRResponse struct {
 	PR PullRequestDTO `json:"pr"`
 }
 
+type PRListResponse struct {
+	PullRequests []PullRequestDTO `json:"pull_requests"`
+	Total        int              `json:"total"`
+	Limit        int              `json:"limit"`
+	Offset       int              `json:"offset"`
+}
+
 type PullRequestDTO struct {
-	PRID              string     `json:"pull_request_id"`
-	PRName            string     `json:"pull_request_name"`
-	AuthorID          string     `json:"author_id"`
-	Status            string     `json:"status"`
-	AssignedReviewers []string   `json:"assigned_reviewers"`
-	CreatedAt         time.Time  `json:"createdAt,omitempty"`
-	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	PRID              string                `json:"pull_request_id"`
+	PRName            string                `json:"pull_request_name"`
+	AuthorID          string                `json:"author_id"`
+	Status            string                `json:"status"`
+	AssignedReviewers []string              `json:"assigned_reviewers"`
+	FallbackReviewers []FallbackReviewerDTO `json:"fallback_reviewers,omitempty"`
+	StaleReviewers    []string              `json:"stale_reviewers,omitempty"`
+	Labels            []string              `json:"labels,omitempty"`
+	CreatedAt         time.Time             `json:"createdAt,omitempty"`
+	MergedAt          *time.Time            `json:"mergedAt,omitempty"`
+}
+
+// FallbackReviewerDTO — ревьюер, назначенный из запасной команды.
+type FallbackReviewerDTO struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
 }
 
 type ReassignReviewerResponse struct {
@@ -53,11 +251,47 @@ type PullRequestShortDTO struct {
 	Status   string `json:"status"`
 }
 
+type OwnershipRulesResponse struct {
+	Rules []OwnershipRuleDTO `json:"rules"`
+}
+
+type ExplainOwnershipResponse struct {
+	Files []FileOwnershipDTO `json:"files"`
+}
+
+type FileOwnershipDTO struct {
+	File string            `json:"file"`
+	Rule *OwnershipRuleDTO `json:"rule"`
+}
+
 type ErrorResponse struct {
 	Error ErrorDetail `json:"error"`
 }
 
+// ErrorDetail — ошибка API. Details — машиночитаемые подробности: для
+// INVALID_REQUEST — поля запроса в fields, для доменных ошибок — идентификаторы
+// сущностей и нарушенные значения.
 type ErrorDetail struct {
-	Code    string `json:"code"`
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// FieldErrorDTO — поле запроса, не прошедшее проверку, и нарушенное правило.
+type FieldErrorDTO struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
 	Message string `json:"message"`
 }
+
+type GraphQLResponse struct {
+	Data   interface{}       `json:"data"`
+	Errors []GraphQLErrorDTO `json:"errors,omitempty"`
+}
+
+// GraphQLErrorDTO — ошибка поля; extensions.code совпадает с error.code REST API.
+type GraphQLErrorDTO struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/absence"
+)
+
+type AbsenceHandler struct {
+	addAbsenceUseCase    *absence.AddAbsenceUseCase
+	getAbsencesUseCase   *absence.GetAbsencesUseCase
+	deleteAbsenceUseCase *absence.DeleteAbsenceUseCase
+}
+
+func NewAbsenceHandler(
+	addAbsenceUseCase *absence.AddAbsenceUseCase,
+	getAbsencesUseCase *absence.GetAbsencesUseCase,
+	deleteAbsenceUseCase *absence.DeleteAbsenceUseCase,
+) *AbsenceHandler {
+	return &AbsenceHandler{
+		addAbsenceUseCase:    addAbsenceUseCase,
+		getAbsencesUseCase:   getAbsencesUseCase,
+		deleteAbsenceUseCase: deleteAbsenceUseCase,
+	}
+}
+
+// AddAbsence godoc
+// @Summary      Добавить период отсутствия
+// @Description  Добавляет период отсутствия пользователя (даты включительно, формат YYYY-MM-DD). В этот период пользователь не назначается ревьюером
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.AddAbsenceRequest  true  "Период отсутствия"
+// @Success      201      {object}  dto.AbsenceResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Router       /users/addAbsence [post]
+func (h *AbsenceHandler) AddAbsence(c *gin.Context) {
+	var req dto.AddAbsenceRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	useCaseReq, err := dto.ToAddAbsenceRequest(req)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "dates must be in YYYY-MM-DD format")
+		return
+	}
+
+	absence, err := h.addAbsenceUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.AbsenceResponse{
+		Absence: dto.ToAbsenceDTO(absence),
+	}
+
+	respondJSON(c, http.StatusCreated, response)
+}
+
+// GetAbsences godoc
+// @Summary      Получить периоды отсутствия пользователя
+// @Description  Возвращает все периоды отсутствия пользователя
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        user_id  query     string  true  "Идентификатор пользователя"
+// @Success      200      {object}  dto.GetAbsencesResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Router       /users/getAbsences [get]
+func (h *AbsenceHandler) GetAbsences(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "user_id is required")
+		return
+	}
+
+	absences, err := h.getAbsencesUseCase.Execute(userID)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	absenceDTOs := make([]dto.AbsenceDTO, 0, len(absences))
+	for _, absence := range absences {
+		absenceDTOs = append(absenceDTOs, dto.ToAbsenceDTO(absence))
+	}
+
+	response := dto.GetAbsencesResponse{
+		UserID:   userID,
+		Absences: absenceDTOs,
+	}
+
+	respondJSON(c, http.StatusOK, response)
+}
+
+// DeleteAbsence godoc
+// @Summary      Удалить период отсутствия
+// @Description  Удаляет период отсутствия по идентификатору
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        request  body  dto.DeleteAbsenceRequest  true  "Идентификатор периода"
+// @Success      204
+// @Failure      404      {object}  dto.ErrorResponse
+// @Router       /users/deleteAbsence [post]
+func (h *AbsenceHandler) DeleteAbsence(c *gin.Context) {
+	var req dto.DeleteAbsenceRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := h.deleteAbsenceUseCase.Execute(req.AbsenceID); err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AbsenceHandler) RegisterRoutes(r *gin.Engine) {
+	r.POST("/users/addAbsence", h.AddAbsence)
+	r.GET("/users/getAbsences", h.GetAbsences)
+	r.POST("/users/deleteAbsence", h.DeleteAbsence)
+}
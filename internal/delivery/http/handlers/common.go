@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"errors"
+	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -23,21 +25,70 @@ func respondError(c *gin.Context, statusCode int, code, message string) {
 	c.JSON(statusCode, response)
 }
 
+// ErrorCatalogueEntry — код ошибки API и HTTP-статус, с которым она
+// возвращается.
+type ErrorCatalogueEntry struct {
+	Code   string
+	Status int
+}
+
+// errorCatalogue — все коды ошибок REST API. Перечисление кодов в openapi.yml и
+// таблица в README повторяют его.
+var errorCatalogue = []ErrorCatalogueEntry{
+	{domain.ErrTeamExists.Code, http.StatusConflict},
+	{domain.ErrPRExists.Code, http.StatusConflict},
+	{domain.ErrPRMerged.Code, http.StatusConflict},
+	{domain.ErrNotAssigned.Code, http.StatusConflict},
+	{domain.ErrNoCandidate.Code, http.StatusConflict},
+	{domain.ErrNotFound.Code, http.StatusNotFound},
+	{domain.ErrInvalidArgument.Code, http.StatusBadRequest},
+	{domain.ErrInvalidStatus.Code, http.StatusBadRequest},
+	{domain.ErrSeniorityRule.Code, http.StatusConflict},
+	{domain.ErrAlreadyAssigned.Code, http.StatusConflict},
+	{domain.ErrReviewerIsAuthor.Code, http.StatusConflict},
+	{domain.ErrReviewerInactive.Code, http.StatusConflict},
+	{domain.ErrTeamMismatch.Code, http.StatusConflict},
+	{domain.ErrPairingRule.Code, http.StatusConflict},
+	{domain.ErrIdempotencyKeyReused.Code, http.StatusUnprocessableEntity},
+	{domain.ErrIdempotencyKeyInProgress.Code, http.StatusConflict},
+	{domain.ErrPreconditionFailed.Code, http.StatusPreconditionFailed},
+	{domain.ErrConcurrentUpdate.Code, http.StatusConflict},
+	{"INVALID_REQUEST", http.StatusBadRequest},
+	{"INTERNAL_ERROR", http.StatusInternalServerError},
+}
+
+// ErrorCatalogue возвращает каталог ошибок REST API.
+func ErrorCatalogue() []ErrorCatalogueEntry {
+	return append([]ErrorCatalogueEntry(nil), errorCatalogue...)
+}
+
+func errorStatus(code string) (int, bool) {
+	for _, entry := range errorCatalogue {
+		if entry.Code == code {
+			return entry.Status, true
+		}
+	}
+	return 0, false
+}
+
+// handleDomainError отвечает кодом, сообщением и подробностями доменной
+// ошибки. Остальные ошибки записываются в лог и отдаются как INTERNAL_ERROR
+// без текста: он может содержать детали БД.
 func handleDomainError(c *gin.Context, err error) {
-	switch err {
-	case domain.ErrTeamExists:
-		respondError(c, http.StatusBadRequest, "TEAM_EXISTS", "team_name already exists")
-	case domain.ErrPRExists:
-		respondError(c, http.StatusConflict, "PR_EXISTS", "PR id already exists")
-	case domain.ErrPRMerged:
-		respondError(c, http.StatusConflict, "PR_MERGED", "cannot reassign on merged PR")
-	case domain.ErrNotAssigned:
-		respondError(c, http.StatusConflict, "NOT_ASSIGNED", "reviewer is not assigned to this PR")
-	case domain.ErrNoCandidate:
-		respondError(c, http.StatusConflict, "NO_CANDIDATE", "no active replacement candidate in team")
-	case domain.ErrNotFound:
-		respondError(c, http.StatusNotFound, "NOT_FOUND", "resource not found")
-	default:
-		respondError(c, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		if status, ok := errorStatus(domainErr.Code); ok {
+			c.JSON(status, dto.ErrorResponse{
+				Error: dto.ErrorDetail{
+					Code:    domainErr.Code,
+					Message: domainErr.Message,
+					Details: domainErr.Details,
+				},
+			})
+			return
+		}
 	}
+
+	log.Printf("%s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+	respondError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "internal server error")
 }
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag ресурса — его версия в кавычках, Last-Modified — время последнего
+// изменения. Версию увеличивает репозиторий при каждой записи.
+
+func formatETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+func setValidators(c *gin.Context, version int64, updatedAt time.Time) {
+	c.Header("ETag", formatETag(version))
+	if !updatedAt.IsZero() {
+		c.Header("Last-Modified", updatedAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+// respondNotModified выставляет валидаторы и отвечает 304, если клиент уже
+// видел эту версию. If-Modified-Since учитывается только без If-None-Match.
+func respondNotModified(c *gin.Context, version int64, updatedAt time.Time) bool {
+	setValidators(c, version, updatedAt)
+
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" {
+		if !etagListMatches(ifNoneMatch, formatETag(version)) {
+			return false
+		}
+	} else {
+		ifModifiedSince, err := http.ParseTime(c.GetHeader("If-Modified-Since"))
+		if err != nil || updatedAt.IsZero() || updatedAt.Truncate(time.Second).After(ifModifiedSince) {
+			return false
+		}
+	}
+
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// etagListMatches — слабое сравнение из If-None-Match: префикс W/ не учитывается.
+func etagListMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion разбирает If-Match в ожидаемую версию. Без заголовка и для *
+// возвращает nil — запись без условия. На некорректный заголовок отвечает 400.
+func ifMatchVersion(c *gin.Context) (*int64, bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, true
+	}
+
+	if len(header) >= 2 && header[0] == '"' && header[len(header)-1] == '"' {
+		if version, err := strconv.ParseInt(header[1:len(header)-1], 10, 64); err == nil && version > 0 {
+			return &version, true
+		}
+	}
+
+	respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "If-Match must be * or a single ETag")
+	return nil, false
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/gql"
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+)
+
+type GraphQLHandler struct {
+	executor *gql.Executor
+}
+
+func NewGraphQLHandler(executor *gql.Executor) *GraphQLHandler {
+	return &GraphQLHandler{
+		executor: executor,
+	}
+}
+
+// Query godoc
+// @Summary      GraphQL-запрос
+// @Description  Выполняет запрос или мутацию по схеме Team → members → reviews → PR → reviewers. Ошибки полей возвращаются в errors с доменным кодом в extensions.code, статус ответа при этом 200
+// @Tags         GraphQL
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.GraphQLRequest  true  "Запрос, имя операции и переменные"
+// @Success      200      {object}  dto.GraphQLResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Router       /graphql [post]
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var req dto.GraphQLRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result := h.executor.Execute(c.Request.Context(), dto.ToGraphQLRequest(req))
+
+	respondJSON(c, http.StatusOK, dto.ToGraphQLResponse(result))
+}
+
+func (h *GraphQLHandler) RegisterRoutes(r *gin.Engine) {
+	r.POST("/graphql", h.Query)
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/idempotency"
+)
+
+const (
+	// IdempotencyKeyHeader — ключ, под которым повторы POST-запроса
+	// распознаются как один запрос.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader выставляется в ответах, повторённых из сохранённых.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+type IdempotencyMiddleware struct {
+	beginUseCase    *idempotency.BeginUseCase
+	completeUseCase *idempotency.CompleteUseCase
+}
+
+func NewIdempotencyMiddleware(
+	beginUseCase *idempotency.BeginUseCase,
+	completeUseCase *idempotency.CompleteUseCase,
+) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		beginUseCase:    beginUseCase,
+		completeUseCase: completeUseCase,
+	}
+}
+
+// Handle выполняет POST-запрос с Idempotency-Key один раз: повтор с тем же
+// ключом и телом получает сохранённый ответ, с другим телом — 422.
+// Запросы без ключа и не POST проходят без изменений.
+func (m *IdempotencyMiddleware) Handle(c *gin.Context) {
+	key := c.GetHeader(IdempotencyKeyHeader)
+	if c.Request.Method != http.MethodPost || key == "" {
+		c.Next()
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "failed to read request body")
+		c.Abort()
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	record, err := m.beginUseCase.Execute(idempotency.BeginRequest{
+		Key:         key,
+		RequestHash: requestHash(c.Request, body),
+	})
+	if err != nil {
+		handleDomainError(c, err)
+		c.Abort()
+		return
+	}
+
+	if record.Completed {
+		for name, values := range record.Headers {
+			c.Writer.Header()[name] = values
+		}
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Writer.WriteHeader(record.StatusCode)
+		c.Writer.Write(record.Body)
+		c.Abort()
+		return
+	}
+
+	recorder := &responseRecorder{ResponseWriter: c.Writer}
+	c.Writer = recorder
+
+	complete := func(statusCode int) {
+		err := m.completeUseCase.Execute(idempotency.CompleteRequest{
+			Record:     record,
+			StatusCode: statusCode,
+			Headers:    recorder.Header().Clone(),
+			Body:       recorder.body.Bytes(),
+		})
+		if err != nil {
+			log.Printf("Failed to save response for idempotency key %q: %v", key, err)
+		}
+	}
+
+	// Паника в обработчике не должна оставить ключ занятым до истечения TTL
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			complete(http.StatusInternalServerError)
+			panic(recovered)
+		}
+	}()
+
+	c.Next()
+	complete(recorder.Status())
+}
+
+// Except возвращает Handle, который пропускает маршруты paths без обработки.
+// Так из-под идемпотентности выводятся POST-маршруты, которые только читают:
+// сохранять их ответы незачем, а повтор должен видеть текущее состояние.
+func (m *IdempotencyMiddleware) Except(paths ...string) gin.HandlerFunc {
+	skip := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		skip[path] = true
+	}
+
+	return func(c *gin.Context) {
+		if skip[c.FullPath()] {
+			c.Next()
+			return
+		}
+		m.Handle(c)
+	}
+}
+
+// requestHash отличает повтор запроса от другого запроса под тем же ключом.
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseRecorder копирует тело ответа, чтобы сохранить его для повторов.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *responseRecorder) WriteString(s string) (int, error) {
+	r.body.WriteString(s)
+	return r.ResponseWriter.WriteString(s)
+}
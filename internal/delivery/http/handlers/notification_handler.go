@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/reminder"
+)
+
+type NotificationHandler struct {
+	setSettingsUseCase *reminder.SetSettingsUseCase
+	getSettingsUseCase *reminder.GetSettingsUseCase
+}
+
+func NewNotificationHandler(
+	setSettingsUseCase *reminder.SetSettingsUseCase,
+	getSettingsUseCase *reminder.GetSettingsUseCase,
+) *NotificationHandler {
+	return &NotificationHandler{
+		setSettingsUseCase: setSettingsUseCase,
+		getSettingsUseCase: getSettingsUseCase,
+	}
+}
+
+// SetNotifications godoc
+// @Summary      Установить настройки напоминаний
+// @Description  Устанавливает адрес для дайджестов и их частоту: DAILY, WEEKLY или OFF (отказ от напоминаний)
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.SetNotificationsRequest  true  "Настройки напоминаний"
+// @Success      200      {object}  dto.NotificationSettingsResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Router       /users/setNotifications [post]
+func (h *NotificationHandler) SetNotifications(c *gin.Context) {
+	var req dto.SetNotificationsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	useCaseReq := dto.ToSetNotificationsRequest(req)
+	settings, err := h.setSettingsUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.NotificationSettingsResponse{
+		Settings: dto.ToNotificationSettingsDTO(settings),
+	}
+
+	respondJSON(c, http.StatusOK, response)
+}
+
+// GetNotifications godoc
+// @Summary      Получить настройки напоминаний
+// @Description  Возвращает настройки дайджестов пользователя. Без сохранённых настроек дайджест отправляется ежедневно
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        user_id  query     string  true  "Идентификатор пользователя"
+// @Success      200      {object}  dto.NotificationSettingsResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Router       /users/getNotifications [get]
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "user_id is required")
+		return
+	}
+
+	settings, err := h.getSettingsUseCase.Execute(userID)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.NotificationSettingsResponse{
+		Settings: dto.ToNotificationSettingsDTO(settings),
+	}
+
+	respondJSON(c, http.StatusOK, response)
+}
+
+func (h *NotificationHandler) RegisterRoutes(r *gin.Engine) {
+	r.POST("/users/setNotifications", h.SetNotifications)
+	r.GET("/users/getNotifications", h.GetNotifications)
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/ownership"
+)
+
+type OwnershipHandler struct {
+	setRulesUseCase *ownership.SetRulesUseCase
+	getRulesUseCase *ownership.GetRulesUseCase
+	explainUseCase  *ownership.ExplainUseCase
+}
+
+func NewOwnershipHandler(
+	setRulesUseCase *ownership.SetRulesUseCase,
+	getRulesUseCase *ownership.GetRulesUseCase,
+	explainUseCase *ownership.ExplainUseCase,
+) *OwnershipHandler {
+	return &OwnershipHandler{
+		setRulesUseCase: setRulesUseCase,
+		getRulesUseCase: getRulesUseCase,
+		explainUseCase:  explainUseCase,
+	}
+}
+
+// SetRules godoc
+// @Summary      Загрузить правила владения кодом
+// @Description  Полностью заменяет правила владения (glob-шаблон пути → команда и/или пользователи). Порядок важен: для файла действует последнее подходящее правило
+// @Tags         Ownership
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.SetOwnershipRulesRequest  true  "Правила владения"
+// @Success      200      {object}  dto.OwnershipRulesResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Router       /ownership/setRules [post]
+func (h *OwnershipHandler) SetRules(c *gin.Context) {
+	var req dto.SetOwnershipRulesRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	useCaseReq := dto.ToSetOwnershipRulesRequest(req)
+	rules, err := h.setRulesUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToOwnershipRulesResponse(rules))
+}
+
+// GetRules godoc
+// @Summary      Получить правила владения кодом
+// @Description  Возвращает текущие правила владения в порядке применения
+// @Tags         Ownership
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  dto.OwnershipRulesResponse
+// @Router       /ownership/getRules [get]
+func (h *OwnershipHandler) GetRules(c *gin.Context) {
+	rules, err := h.getRulesUseCase.Execute()
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToOwnershipRulesResponse(rules))
+}
+
+// Explain godoc
+// @Summary      Проверить правила владения (dry-run)
+// @Description  Показывает, какое правило сработало для каждого файла, ничего не сохраняя
+// @Tags         Ownership
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.ExplainOwnershipRequest  true  "Список изменённых файлов"
+// @Success      200      {object}  dto.ExplainOwnershipResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Router       /ownership/explain [post]
+func (h *OwnershipHandler) Explain(c *gin.Context) {
+	var req dto.ExplainOwnershipRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	useCaseReq := ownership.ExplainRequest{
+		ChangedFiles: req.ChangedFiles,
+	}
+
+	files, err := h.explainUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	fileDTOs := make([]dto.FileOwnershipDTO, 0, len(files))
+	for _, file := range files {
+		fileDTOs = append(fileDTOs, dto.ToFileOwnershipDTO(file))
+	}
+
+	response := dto.ExplainOwnershipResponse{
+		Files: fileDTOs,
+	}
+
+	respondJSON(c, http.StatusOK, response)
+}
+
+func (h *OwnershipHandler) RegisterRoutes(r *gin.Engine) {
+	r.POST("/ownership/setRules", h.SetRules)
+	r.GET("/ownership/getRules", h.GetRules)
+	r.POST("/ownership/explain", h.Explain)
+}
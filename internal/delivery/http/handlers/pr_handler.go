@@ -1,11 +1,13 @@
 package handlers
 
 import (
+	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/escalation"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
 )
 
@@ -13,23 +15,59 @@ type PRHandler struct {
 	createPRUseCase         *pr.CreatePRUseCase
 	mergePRUseCase          *pr.MergePRUseCase
 	reassignReviewerUseCase *pr.ReassignReviewerUseCase
+	getHistoryUseCase       *pr.GetHistoryUseCase
+	getEscalationsUseCase   *escalation.GetEscalationsUseCase
+	previewPRUseCase        *pr.PreviewPRUseCase
+	getReasoningUseCase     *pr.GetReasoningUseCase
+	addReviewerUseCase      *pr.AddReviewerUseCase
+	removeReviewerUseCase   *pr.RemoveReviewerUseCase
+	backfillUseCase         *pr.BackfillReviewersUseCase
+	getPRUseCase            *pr.GetPRUseCase
+	listPRsUseCase          *pr.ListPRsUseCase
+	batchCreateUseCase      *pr.BatchCreatePRsUseCase
+	batchMergeUseCase       *pr.BatchMergePRsUseCase
+	batchReassignUseCase    *pr.BatchReassignReviewersUseCase
 }
 
 func NewPRHandler(
 	createPRUseCase *pr.CreatePRUseCase,
 	mergePRUseCase *pr.MergePRUseCase,
 	reassignReviewerUseCase *pr.ReassignReviewerUseCase,
+	getHistoryUseCase *pr.GetHistoryUseCase,
+	getEscalationsUseCase *escalation.GetEscalationsUseCase,
+	previewPRUseCase *pr.PreviewPRUseCase,
+	getReasoningUseCase *pr.GetReasoningUseCase,
+	addReviewerUseCase *pr.AddReviewerUseCase,
+	removeReviewerUseCase *pr.RemoveReviewerUseCase,
+	backfillUseCase *pr.BackfillReviewersUseCase,
+	getPRUseCase *pr.GetPRUseCase,
+	listPRsUseCase *pr.ListPRsUseCase,
+	batchCreateUseCase *pr.BatchCreatePRsUseCase,
+	batchMergeUseCase *pr.BatchMergePRsUseCase,
+	batchReassignUseCase *pr.BatchReassignReviewersUseCase,
 ) *PRHandler {
 	return &PRHandler{
 		createPRUseCase:         createPRUseCase,
 		mergePRUseCase:          mergePRUseCase,
 		reassignReviewerUseCase: reassignReviewerUseCase,
+		getHistoryUseCase:       getHistoryUseCase,
+		getEscalationsUseCase:   getEscalationsUseCase,
+		previewPRUseCase:        previewPRUseCase,
+		getReasoningUseCase:     getReasoningUseCase,
+		addReviewerUseCase:      addReviewerUseCase,
+		removeReviewerUseCase:   removeReviewerUseCase,
+		backfillUseCase:         backfillUseCase,
+		getPRUseCase:            getPRUseCase,
+		listPRsUseCase:          listPRsUseCase,
+		batchCreateUseCase:      batchCreateUseCase,
+		batchMergeUseCase:       batchMergeUseCase,
+		batchReassignUseCase:    batchReassignUseCase,
 	}
 }
 
 // CreatePR godoc
 // @Summary      Создать PR и назначить ревьюеров
-// @Description  Создаёт PR и автоматически назначает до 2 ревьюеров из команды автора
+// @Description  Создаёт PR и автоматически назначает до 2 ревьюеров из команды автора, а также по одному владельцу на каждое сработавшее правило владения для changed_files. Кандидаты с тегами экспертизы, совпадающими с labels, имеют приоритет
 // @Tags         PullRequests
 // @Accept       json
 // @Produce      json
@@ -40,15 +78,16 @@ func NewPRHandler(
 // @Router       /pullRequest/create [post]
 func (h *PRHandler) CreatePR(c *gin.Context) {
 	var req dto.CreatePRRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	useCaseReq := pr.CreatePRRequest{
-		PRID:     req.PRID,
-		PRName:   req.PRName,
-		AuthorID: req.AuthorID,
+		PRID:         req.PRID,
+		PRName:       req.PRName,
+		AuthorID:     req.AuthorID,
+		ChangedFiles: req.ChangedFiles,
+		Labels:       req.Labels,
 	}
 
 	pr, err := h.createPRUseCase.Execute(useCaseReq)
@@ -64,25 +103,65 @@ func (h *PRHandler) CreatePR(c *gin.Context) {
 	respondJSON(c, http.StatusCreated, response)
 }
 
+// PreviewPR godoc
+// @Summary      Предпросмотр назначения ревьюеров
+// @Description  Выполняет тот же подбор ревьюеров, что и создание PR, но ничего не сохраняет. Возвращает выбранных ревьюеров и решение по каждому кандидату с причиной: выбран, не выбран или исключён
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.PreviewPRRequest  true  "Автор, изменённые файлы и метки"
+// @Success      200      {object}  dto.PreviewPRResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Failure      409      {object}  dto.ErrorResponse
+// @Router       /pullRequest/preview [post]
+func (h *PRHandler) PreviewPR(c *gin.Context) {
+	var req dto.PreviewPRRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	useCaseReq := pr.PreviewPRRequest{
+		AuthorID:     req.AuthorID,
+		ChangedFiles: req.ChangedFiles,
+		Labels:       req.Labels,
+	}
+
+	assignment, err := h.previewPRUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToPreviewPRResponse(req.AuthorID, assignment))
+}
+
 // MergePR godoc
 // @Summary      Пометить PR как MERGED
 // @Description  Помечает PR как MERGED (идемпотентная операция)
 // @Tags         PullRequests
 // @Accept       json
 // @Produce      json
-// @Param        request  body      dto.MergePRRequest  true  "ID PR"
-// @Success      200      {object}  dto.PRResponse
-// @Failure      404      {object}  dto.ErrorResponse
+// @Param        request   body      dto.MergePRRequest  true  "ID PR"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.PRResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
 // @Router       /pullRequest/merge [post]
 func (h *PRHandler) MergePR(c *gin.Context) {
 	var req dto.MergePRRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
 		return
 	}
 
 	useCaseReq := pr.MergePRRequest{
-		PRID: req.PRID,
+		PRID:    req.PRID,
+		IfMatch: ifMatch,
 	}
 
 	pr, err := h.mergePRUseCase.Execute(useCaseReq)
@@ -95,30 +174,40 @@ func (h *PRHandler) MergePR(c *gin.Context) {
 		PR: dto.ToPullRequestDTO(pr),
 	}
 
+	setValidators(c, pr.Version, pr.UpdatedAt)
 	respondJSON(c, http.StatusOK, response)
 }
 
 // ReassignReviewer godoc
 // @Summary      Переназначить ревьюера
-// @Description  Переназначает конкретного ревьювера на другого из его команды
+// @Description  Переназначает конкретного ревьювера на другого из его команды. Если передан new_user_id, замена назначается на этого пользователя, иначе выбирается автоматически
 // @Tags         PullRequests
 // @Accept       json
 // @Produce      json
-// @Param        request  body      dto.ReassignReviewerRequest  true  "Данные переназначения"
-// @Success      200      {object}  dto.ReassignReviewerResponse
-// @Failure      404      {object}  dto.ErrorResponse
-// @Failure      409      {object}  dto.ErrorResponse
+// @Param        request   body      dto.ReassignReviewerRequest  true  "Данные переназначения"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.ReassignReviewerResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      409       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
 // @Router       /pullRequest/reassign [post]
 func (h *PRHandler) ReassignReviewer(c *gin.Context) {
 	var req dto.ReassignReviewerRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
 		return
 	}
 
 	useCaseReq := pr.ReassignReviewerRequest{
 		PRID:      req.PRID,
 		OldUserID: req.OldUserID,
+		NewUserID: req.NewUserID,
+		IfMatch:   ifMatch,
 	}
 
 	result, err := h.reassignReviewerUseCase.Execute(useCaseReq)
@@ -132,11 +221,398 @@ func (h *PRHandler) ReassignReviewer(c *gin.Context) {
 		ReplacedBy: result.ReplacedBy,
 	}
 
+	setValidators(c, result.PR.Version, result.PR.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// AddReviewer godoc
+// @Summary      Назначить ревьюера вручную
+// @Description  Добавляет указанного пользователя к ревьюерам PR. Пользователь должен быть активным участником команды автора, не автором и не назначенным ранее
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.ReviewerChangeRequest  true  "PR и ревьюер"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.PRResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      409       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /pullRequest/addReviewer [post]
+func (h *PRHandler) AddReviewer(c *gin.Context) {
+	var req dto.ReviewerChangeRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := pr.AddReviewerRequest{
+		PRID:    req.PRID,
+		UserID:  req.UserID,
+		IfMatch: ifMatch,
+	}
+
+	pr, err := h.addReviewerUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.PRResponse{
+		PR: dto.ToPullRequestDTO(pr),
+	}
+
+	setValidators(c, pr.Version, pr.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// RemoveReviewer godoc
+// @Summary      Снять ревьюера без замены
+// @Description  Убирает ревьюера из PR, не назначая замену. Запрещено, если без него нарушится правило старшинства команды автора
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.ReviewerChangeRequest  true  "PR и ревьюер"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.PRResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      409       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /pullRequest/removeReviewer [post]
+func (h *PRHandler) RemoveReviewer(c *gin.Context) {
+	var req dto.ReviewerChangeRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := pr.RemoveReviewerRequest{
+		PRID:    req.PRID,
+		UserID:  req.UserID,
+		IfMatch: ifMatch,
+	}
+
+	pr, err := h.removeReviewerUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.PRResponse{
+		PR: dto.ToPullRequestDTO(pr),
+	}
+
+	setValidators(c, pr.Version, pr.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// Backfill godoc
+// @Summary      Добрать ревьюеров открытым PR
+// @Description  Находит открытые PR, у которых меньше ревьюеров, чем цель команды автора (reviewers_target, по умолчанию 2), и добирает недостающих из команды автора и её запасных команд по её правилам старшинства и пар. Возвращает отчёт по каждому такому PR: кто добавлен, сколько ещё не хватает и код ошибки, если PR обработать не удалось
+// @Tags         PullRequests
+// @Produce      json
+// @Success      200  {object}  dto.BackfillResponse
+// @Router       /pullRequest/backfill [post]
+func (h *PRHandler) Backfill(c *gin.Context) {
+	report, err := h.backfillUseCase.Execute(pr.BackfillReviewersRequest{})
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToBackfillResponse(report))
+}
+
+// BatchCreate godoc
+// @Summary      Создать PR пакетом
+// @Description  Создаёт до 500 PR так же, как /pullRequest/create, и возвращает итог по каждому элементу. mode=BEST_EFFORT (по умолчанию) фиксирует пакет частями по 50 и пропускает неудавшиеся элементы; mode=ALL_OR_NOTHING при первой ошибке откатывает весь пакет
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.BatchCreatePRsRequest  true  "Режим и PR"
+// @Success      200      {object}  dto.BatchResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Router       /pullRequest/batchCreate [post]
+func (h *PRHandler) BatchCreate(c *gin.Context) {
+	var req dto.BatchCreatePRsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.batchCreateUseCase.Execute(dto.ToBatchCreatePRsRequest(req))
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondBatch(c, result)
+}
+
+// BatchMerge godoc
+// @Summary      Смёрджить PR пакетом
+// @Description  Мёрджит до 500 PR и возвращает итог по каждому элементу. Режимы те же, что у /pullRequest/batchCreate
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.BatchMergePRsRequest  true  "Режим и PR"
+// @Success      200      {object}  dto.BatchResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Router       /pullRequest/batchMerge [post]
+func (h *PRHandler) BatchMerge(c *gin.Context) {
+	var req dto.BatchMergePRsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.batchMergeUseCase.Execute(dto.ToBatchMergePRsRequest(req))
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondBatch(c, result)
+}
+
+// BatchReassign godoc
+// @Summary      Переназначить ревьюеров пакетом
+// @Description  Переназначает ревьюеров в до 500 PR так же, как /pullRequest/reassign, и возвращает итог по каждому элементу с replaced_by. Режимы те же, что у /pullRequest/batchCreate
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.BatchReassignReviewersRequest  true  "Режим и переназначения"
+// @Success      200      {object}  dto.BatchResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Router       /pullRequest/batchReassign [post]
+func (h *PRHandler) BatchReassign(c *gin.Context) {
+	var req dto.BatchReassignReviewersRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.batchReassignUseCase.Execute(dto.ToBatchReassignReviewersRequest(req))
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondBatch(c, result)
+}
+
+// GetPR godoc
+// @Summary      Получить PR
+// @Description  Возвращает PR с назначенными ревьюерами и метками
+// @Tags         PullRequests
+// @Produce      json
+// @Param        pull_request_id  query     string  true  "Идентификатор PR"
+// @Param        If-None-Match    header    string  false  "ETag, полученный ранее"
+// @Success      200              {object}  dto.PRResponse
+// @Header       200              {string}  ETag  "Версия ресурса"
+// @Header       200              {string}  Last-Modified  "Время последнего изменения"
+// @Success      304              "Ресурс не изменился"
+// @Failure      404              {object}  dto.ErrorResponse
+// @Router       /pullRequest/get [get]
+func (h *PRHandler) GetPR(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "pull_request_id is required")
+		return
+	}
+
+	pr, err := h.getPRUseCase.Execute(prID)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	if respondNotModified(c, pr.Version, pr.UpdatedAt) {
+		return
+	}
+
+	response := dto.PRResponse{
+		PR: dto.ToPullRequestDTO(pr),
+	}
+
+	respondJSON(c, http.StatusOK, response)
+}
+
+// ListPRs godoc
+// @Summary      Список PR
+// @Description  Возвращает страницу PR от новых к старым. Фильтры необязательны и объединяются по И: автор, команда автора, ревьюер, статус и интервал дат создания (YYYY-MM-DD, обе границы включительно). total — число PR под фильтром без учёта страницы
+// @Tags         PullRequests
+// @Produce      json
+// @Param        author_id     query     string  false  "Автор"
+// @Param        team_name     query     string  false  "Команда автора"
+// @Param        reviewer_id   query     string  false  "Назначенный ревьюер"
+// @Param        status        query     string  false  "Статус"  Enums(OPEN, MERGED)
+// @Param        created_from  query     string  false  "Создан не раньше, YYYY-MM-DD"
+// @Param        created_to    query     string  false  "Создан не позже, YYYY-MM-DD"
+// @Param        limit         query     int     false  "Размер страницы, по умолчанию 20, не больше 100"
+// @Param        offset        query     int     false  "Смещение"
+// @Success      200           {object}  dto.PRListResponse
+// @Failure      400           {object}  dto.ErrorResponse
+// @Router       /pullRequest/list [get]
+func (h *PRHandler) ListPRs(c *gin.Context) {
+	var query dto.ListPRsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondInvalidRequest(c, err, "invalid query parameters")
+		return
+	}
+
+	useCaseReq, err := dto.ToListPRsRequest(query)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "dates must be in YYYY-MM-DD format")
+		return
+	}
+
+	result, err := h.listPRsUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToPRListResponse(result))
+}
+
+// GetHistory godoc
+// @Summary      Получить историю назначений PR
+// @Description  Возвращает назначения и переназначения ревьюеров PR вместе с seed, по которому можно воспроизвести выбор
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        pull_request_id  query     string  true  "Идентификатор PR"
+// @Success      200              {object}  dto.AssignmentHistoryResponse
+// @Failure      404              {object}  dto.ErrorResponse
+// @Router       /pullRequest/getHistory [get]
+func (h *PRHandler) GetHistory(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "pull_request_id is required")
+		return
+	}
+
+	records, err := h.getHistoryUseCase.Execute(pr.GetHistoryRequest{PRID: prID})
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	history := make([]dto.AssignmentRecordDTO, 0, len(records))
+	for _, record := range records {
+		history = append(history, dto.ToAssignmentRecordDTO(record))
+	}
+
+	response := dto.AssignmentHistoryResponse{
+		PRID:    prID,
+		History: history,
+	}
+
+	respondJSON(c, http.StatusOK, response)
+}
+
+// GetReasoning godoc
+// @Summary      Получить обоснование назначений PR
+// @Description  Возвращает для каждого назначения и переназначения PR решение по каждому кандидату: выбран, не выбран или исключён, и почему
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        pull_request_id  query     string  true  "Идентификатор PR"
+// @Success      200              {object}  dto.AssignmentReasoningResponse
+// @Failure      404              {object}  dto.ErrorResponse
+// @Router       /pullRequest/getReasoning [get]
+func (h *PRHandler) GetReasoning(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "pull_request_id is required")
+		return
+	}
+
+	reasonings, err := h.getReasoningUseCase.Execute(pr.GetReasoningRequest{PRID: prID})
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	reasoningDTOs := make([]dto.AssignmentReasoningDTO, 0, len(reasonings))
+	for _, reasoning := range reasonings {
+		reasoningDTOs = append(reasoningDTOs, dto.ToAssignmentReasoningDTO(reasoning))
+	}
+
+	response := dto.AssignmentReasoningResponse{
+		PRID:      prID,
+		Reasoning: reasoningDTOs,
+	}
+
+	respondJSON(c, http.StatusOK, response)
+}
+
+// GetEscalations godoc
+// @Summary      Получить эскалации PR
+// @Description  Возвращает действия, выполненные из-за нарушения SLA первого ревью
+// @Tags         PullRequests
+// @Accept       json
+// @Produce      json
+// @Param        pull_request_id  query     string  true  "Идентификатор PR"
+// @Success      200              {object}  dto.EscalationsResponse
+// @Failure      404              {object}  dto.ErrorResponse
+// @Router       /pullRequest/getEscalations [get]
+func (h *PRHandler) GetEscalations(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "pull_request_id is required")
+		return
+	}
+
+	escalations, err := h.getEscalationsUseCase.Execute(prID)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	escalationDTOs := make([]dto.EscalationDTO, 0, len(escalations))
+	for _, escalation := range escalations {
+		escalationDTOs = append(escalationDTOs, dto.ToEscalationDTO(escalation))
+	}
+
+	response := dto.EscalationsResponse{
+		PRID:        prID,
+		Escalations: escalationDTOs,
+	}
+
 	respondJSON(c, http.StatusOK, response)
 }
 
 func (h *PRHandler) RegisterRoutes(r *gin.Engine) {
 	r.POST("/pullRequest/create", h.CreatePR)
+	r.POST("/pullRequest/preview", h.PreviewPR)
 	r.POST("/pullRequest/merge", h.MergePR)
 	r.POST("/pullRequest/reassign", h.ReassignReviewer)
+	r.POST("/pullRequest/addReviewer", h.AddReviewer)
+	r.POST("/pullRequest/removeReviewer", h.RemoveReviewer)
+	r.POST("/pullRequest/backfill", h.Backfill)
+	r.POST("/pullRequest/batchCreate", h.BatchCreate)
+	r.POST("/pullRequest/batchMerge", h.BatchMerge)
+	r.POST("/pullRequest/batchReassign", h.BatchReassign)
+	r.GET("/pullRequest/get", h.GetPR)
+	r.GET("/pullRequest/list", h.ListPRs)
+	r.GET("/pullRequest/getHistory", h.GetHistory)
+	r.GET("/pullRequest/getReasoning", h.GetReasoning)
+	r.GET("/pullRequest/getEscalations", h.GetEscalations)
+}
+
+// respondBatch отдаёт итог пакета. Если пакет остановлен ошибкой БД, причина
+// пишется в лог: клиент видит только её код.
+func respondBatch(c *gin.Context, result *pr.BatchResult) {
+	if result.Err != nil {
+		log.Printf("%s %s: batch aborted: %v", c.Request.Method, c.Request.URL.Path, result.Err)
+	}
+	respondJSON(c, http.StatusOK, dto.ToBatchResponse(result))
 }
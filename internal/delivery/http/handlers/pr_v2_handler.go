@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+)
+
+// PRV2Handler — ресурс /api/v2/pull-requests.
+type PRV2Handler struct {
+	getPRUseCase            *pr.GetPRUseCase
+	reassignReviewerUseCase *pr.ReassignReviewerUseCase
+}
+
+func NewPRV2Handler(getPRUseCase *pr.GetPRUseCase, reassignReviewerUseCase *pr.ReassignReviewerUseCase) *PRV2Handler {
+	return &PRV2Handler{
+		getPRUseCase:            getPRUseCase,
+		reassignReviewerUseCase: reassignReviewerUseCase,
+	}
+}
+
+// GetPR godoc
+// @Summary      Получить PR
+// @Description  Возвращает PR с ревьюерами и метками
+// @Tags         PullRequests v2
+// @Produce      json
+// @Param        pull_request_id  path      string  true  "Идентификатор PR"
+// @Param        If-None-Match    header    string  false  "ETag, полученный ранее"
+// @Success      200              {object}  dto.PullRequestDTO
+// @Header       200              {string}  ETag  "Версия ресурса"
+// @Header       200              {string}  Last-Modified  "Время последнего изменения"
+// @Success      304              "Ресурс не изменился"
+// @Failure      400              {object}  dto.ErrorResponse
+// @Failure      404              {object}  dto.ErrorResponse
+// @Router       /api/v2/pull-requests/{pull_request_id} [get]
+func (h *PRV2Handler) GetPR(c *gin.Context) {
+	var path dto.PRPath
+	if !bindURI(c, &path) {
+		return
+	}
+
+	found, err := h.getPRUseCase.Execute(path.PRID)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	if respondNotModified(c, found.Version, found.UpdatedAt) {
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToPullRequestDTO(found))
+}
+
+// ReviewerAction godoc
+// @Summary      Переназначить ревьюера
+// @Description  Действие над ревьюером PR в виде {user_id}:{действие}. Поддерживается reassign: замена выбирается автоматически или берётся из new_user_id. Тело запроса необязательно
+// @Tags         PullRequests v2
+// @Accept       json
+// @Produce      json
+// @Param        pull_request_id  path      string                 true   "Идентификатор PR"
+// @Param        reviewer_action  path      string                 true   "Ревьюер и действие, например u2:reassign"
+// @Param        request          body      dto.ReassignToRequest  false  "Выбранная замена"
+// @Param        If-Match         header    string                 false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200              {object}  dto.ReassignReviewerResponse
+// @Header       200              {string}  ETag  "Версия ресурса"
+// @Failure      400              {object}  dto.ErrorResponse
+// @Failure      404              {object}  dto.ErrorResponse
+// @Failure      409              {object}  dto.ErrorResponse
+// @Failure      412              {object}  dto.ErrorResponse
+// @Router       /api/v2/pull-requests/{pull_request_id}/reviewers/{reviewer_action} [post]
+func (h *PRV2Handler) ReviewerAction(c *gin.Context) {
+	// Gin не разбирает суффикс после параметра, поэтому действие отделяется
+	// от идентификатора ревьюера здесь
+	reviewerAction := c.Param("reviewer_action")
+	separator := strings.LastIndex(reviewerAction, ":")
+	if separator < 0 || reviewerAction[separator+1:] != "reassign" {
+		respondError(c, http.StatusNotFound, "NOT_FOUND", "unknown reviewer action")
+		return
+	}
+
+	path := dto.ReviewerPath{
+		PRID:       c.Param("pull_request_id"),
+		ReviewerID: reviewerAction[:separator],
+	}
+	if err := binding.Validator.ValidateStruct(&path); err != nil {
+		respondInvalidRequest(c, err, "invalid path parameters")
+		return
+	}
+
+	var req dto.ReassignToRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondInvalidRequest(c, err, "invalid request body")
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.reassignReviewerUseCase.Execute(pr.ReassignReviewerRequest{
+		PRID:      path.PRID,
+		OldUserID: path.ReviewerID,
+		NewUserID: req.NewUserID,
+		IfMatch:   ifMatch,
+	})
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.ReassignReviewerResponse{
+		PR:         dto.ToPullRequestDTO(result.PR),
+		ReplacedBy: result.ReplacedBy,
+	}
+
+	setValidators(c, result.PR.Version, result.PR.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+func (h *PRV2Handler) RegisterRoutes(r *gin.RouterGroup) {
+	r.GET("/pull-requests/:pull_request_id", h.GetPR)
+	r.POST("/pull-requests/:pull_request_id/reviewers/:reviewer_action", h.ReviewerAction)
+}
@@ -10,17 +10,35 @@ import (
 )
 
 type TeamHandler struct {
-	createTeamUseCase *team.CreateTeamUseCase
-	getTeamUseCase    *team.GetTeamUseCase
+	createTeamUseCase         *team.CreateTeamUseCase
+	getTeamUseCase            *team.GetTeamUseCase
+	setSeniorityRuleUseCase   *team.SetSeniorityRuleUseCase
+	setSLAPolicyUseCase       *team.SetSLAPolicyUseCase
+	setPairingRulesUseCase    *team.SetPairingRulesUseCase
+	explainPairingUseCase     *team.ExplainPairingUseCase
+	setFallbackTeamsUseCase   *team.SetFallbackTeamsUseCase
+	setReviewersTargetUseCase *team.SetReviewersTargetUseCase
 }
 
 func NewTeamHandler(
 	createTeamUseCase *team.CreateTeamUseCase,
 	getTeamUseCase *team.GetTeamUseCase,
+	setSeniorityRuleUseCase *team.SetSeniorityRuleUseCase,
+	setSLAPolicyUseCase *team.SetSLAPolicyUseCase,
+	setPairingRulesUseCase *team.SetPairingRulesUseCase,
+	explainPairingUseCase *team.ExplainPairingUseCase,
+	setFallbackTeamsUseCase *team.SetFallbackTeamsUseCase,
+	setReviewersTargetUseCase *team.SetReviewersTargetUseCase,
 ) *TeamHandler {
 	return &TeamHandler{
-		createTeamUseCase: createTeamUseCase,
-		getTeamUseCase:    getTeamUseCase,
+		createTeamUseCase:         createTeamUseCase,
+		getTeamUseCase:            getTeamUseCase,
+		setSeniorityRuleUseCase:   setSeniorityRuleUseCase,
+		setSLAPolicyUseCase:       setSLAPolicyUseCase,
+		setPairingRulesUseCase:    setPairingRulesUseCase,
+		explainPairingUseCase:     explainPairingUseCase,
+		setFallbackTeamsUseCase:   setFallbackTeamsUseCase,
+		setReviewersTargetUseCase: setReviewersTargetUseCase,
 	}
 }
 
@@ -36,8 +54,7 @@ func NewTeamHandler(
 // @Router       /team/add [post]
 func (h *TeamHandler) CreateTeam(c *gin.Context) {
 	var req dto.CreateTeamRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -61,9 +78,14 @@ func (h *TeamHandler) CreateTeam(c *gin.Context) {
 // @Tags         Teams
 // @Accept       json
 // @Produce      json
-// @Param        team_name  query     string  true  "Уникальное имя команды"
-// @Success      200        {object}  dto.TeamDTO
-// @Failure      404        {object}  dto.ErrorResponse
+// @Param        team_name      query     string  true  "Уникальное имя команды"
+// @Param        If-None-Match  header    string  false  "ETag, полученный ранее"
+// @Success      200            {object}  dto.TeamResponse
+// @Header       200            {string}  ETag  "Версия ресурса"
+// @Header       200            {string}  Last-Modified  "Время последнего изменения"
+// @Success      304            "Ресурс не изменился"
+// @Failure      400            {object}  dto.ErrorResponse
+// @Failure      404            {object}  dto.ErrorResponse
 // @Router       /team/get [get]
 func (h *TeamHandler) GetTeam(c *gin.Context) {
 	teamName := c.Query("team_name")
@@ -78,10 +100,257 @@ func (h *TeamHandler) GetTeam(c *gin.Context) {
 		return
 	}
 
-	respondJSON(c, http.StatusOK, dto.ToTeamDTO(team))
+	if respondNotModified(c, team.Version, team.UpdatedAt) {
+		return
+	}
+
+	response := dto.TeamResponse{
+		Team: dto.ToTeamDTO(team),
+	}
+
+	respondJSON(c, http.StatusOK, response)
+}
+
+// SetSeniorityRule godoc
+// @Summary      Установить правило старшинства ревьюеров
+// @Description  Требует не меньше min_reviewers ревьюеров уровня min_level или выше на каждом PR команды. min_reviewers = 0 снимает правило
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.SetSeniorityRuleRequest  true  "Правило старшинства"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.TeamResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /team/setSeniorityRule [post]
+func (h *TeamHandler) SetSeniorityRule(c *gin.Context) {
+	var req dto.SetSeniorityRuleRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToSetSeniorityRuleRequest(req)
+	useCaseReq.IfMatch = ifMatch
+	team, err := h.setSeniorityRuleUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.TeamResponse{
+		Team: dto.ToTeamDTO(team),
+	}
+
+	setValidators(c, team.Version, team.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// SetSLA godoc
+// @Summary      Установить SLA первого ревью команды
+// @Description  Задаёт срок первого ревью (Go duration, например 24h) для PR авторов команды и действие при его нарушении: ADD_REVIEWER, REPLACE_REVIEWER или NOTIFY. Пустой review_sla снимает политику
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.SetSLAPolicyRequest  true  "Политика SLA"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.TeamResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /team/setSLA [post]
+func (h *TeamHandler) SetSLA(c *gin.Context) {
+	var req dto.SetSLAPolicyRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToSetSLAPolicyRequest(req)
+	useCaseReq.IfMatch = ifMatch
+	team, err := h.setSLAPolicyUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.TeamResponse{
+		Team: dto.ToTeamDTO(team),
+	}
+
+	setValidators(c, team.Version, team.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// SetPairingRules godoc
+// @Summary      Установить правила пар автор–ревьюер
+// @Description  Полностью заменяет правила команды: NEVER_PAIR (пара никогда не ревьюит друг друга), PREFER_PAIR (ревьюер выбирается в первую очередь для PR автора), ALWAYS_INCLUDE (ревьюер всегда назначается на PR автора, а без author_id — на любой PR команды)
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.SetPairingRulesRequest  true  "Правила пар"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.TeamResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /team/setPairingRules [post]
+func (h *TeamHandler) SetPairingRules(c *gin.Context) {
+	var req dto.SetPairingRulesRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToSetPairingRulesRequest(req)
+	useCaseReq.IfMatch = ifMatch
+	team, err := h.setPairingRulesUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.TeamResponse{
+		Team: dto.ToTeamDTO(team),
+	}
+
+	setValidators(c, team.Version, team.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// SetFallbackTeams godoc
+// @Summary      Установить запасные команды
+// @Description  Полностью заменяет запасные команды. К ним по порядку обращаются, когда в команде не хватает свободных кандидатов в ревьюеры. Пустой список снимает запасные команды
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.SetFallbackTeamsRequest  true  "Запасные команды в порядке обращения"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.TeamResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /team/setFallbackTeams [post]
+func (h *TeamHandler) SetFallbackTeams(c *gin.Context) {
+	var req dto.SetFallbackTeamsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToSetFallbackTeamsRequest(req)
+	useCaseReq.IfMatch = ifMatch
+	team, err := h.setFallbackTeamsUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.TeamResponse{
+		Team: dto.ToTeamDTO(team),
+	}
+
+	setValidators(c, team.Version, team.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// SetReviewersTarget godoc
+// @Summary      Установить число ревьюеров на PR
+// @Description  Задаёт, сколько ревьюеров назначается на PR авторов команды и до скольких их добирает /pullRequest/backfill. 0 возвращает значение по умолчанию (2)
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.SetReviewersTargetRequest  true  "Цель по числу ревьюеров"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.TeamResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /team/setReviewersTarget [post]
+func (h *TeamHandler) SetReviewersTarget(c *gin.Context) {
+	var req dto.SetReviewersTargetRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToSetReviewersTargetRequest(req)
+	useCaseReq.IfMatch = ifMatch
+	team, err := h.setReviewersTargetUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.TeamResponse{
+		Team: dto.ToTeamDTO(team),
+	}
+
+	setValidators(c, team.Version, team.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// ExplainPairing godoc
+// @Summary      Проверить правила пар для автора (dry-run)
+// @Description  Показывает для каждого участника команды автора, исключён ли он, обязателен или предпочтителен и какое правило сработало. Ничего не сохраняет
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.ExplainPairingRequest  true  "Автор PR"
+// @Success      200      {object}  dto.ExplainPairingResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Router       /team/explainPairing [post]
+func (h *TeamHandler) ExplainPairing(c *gin.Context) {
+	var req dto.ExplainPairingRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	resp, err := h.explainPairingUseCase.Execute(team.ExplainPairingRequest{
+		AuthorID: req.AuthorID,
+	})
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToExplainPairingResponse(resp))
 }
 
 func (h *TeamHandler) RegisterRoutes(r *gin.Engine) {
 	r.POST("/team/add", h.CreateTeam)
 	r.GET("/team/get", h.GetTeam)
+	r.POST("/team/setSeniorityRule", h.SetSeniorityRule)
+	r.POST("/team/setSLA", h.SetSLA)
+	r.POST("/team/setPairingRules", h.SetPairingRules)
+	r.POST("/team/explainPairing", h.ExplainPairing)
+	r.POST("/team/setFallbackTeams", h.SetFallbackTeams)
+	r.POST("/team/setReviewersTarget", h.SetReviewersTarget)
 }
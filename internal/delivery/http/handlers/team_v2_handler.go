@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/team"
+)
+
+// TeamV2Handler — ресурс /api/v2/teams. Использует те же сценарии, что и
+// TeamHandler, но отдаёт ресурсы без обёрток и адресует их путём.
+type TeamV2Handler struct {
+	createTeamUseCase *team.CreateTeamUseCase
+	getTeamUseCase    *team.GetTeamUseCase
+	listTeamsUseCase  *team.ListTeamsUseCase
+}
+
+func NewTeamV2Handler(
+	createTeamUseCase *team.CreateTeamUseCase,
+	getTeamUseCase *team.GetTeamUseCase,
+	listTeamsUseCase *team.ListTeamsUseCase,
+) *TeamV2Handler {
+	return &TeamV2Handler{
+		createTeamUseCase: createTeamUseCase,
+		getTeamUseCase:    getTeamUseCase,
+		listTeamsUseCase:  listTeamsUseCase,
+	}
+}
+
+// ListTeams godoc
+// @Summary      Список команд
+// @Description  Возвращает все команды с участниками, отсортированные по имени. Правила команды есть только в GET /api/v2/teams/{team_name}
+// @Tags         Teams v2
+// @Produce      json
+// @Success      200  {object}  dto.TeamListResponse
+// @Router       /api/v2/teams [get]
+func (h *TeamV2Handler) ListTeams(c *gin.Context) {
+	teams, err := h.listTeamsUseCase.Execute()
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToTeamListResponse(teams))
+}
+
+// CreateTeam godoc
+// @Summary      Создать команду
+// @Description  Создаёт команду с участниками и возвращает её; адрес новой команды — в заголовке Location
+// @Tags         Teams v2
+// @Accept       json
+// @Produce      json
+// @Param        team  body      dto.CreateTeamRequest  true  "Данные команды"
+// @Success      201   {object}  dto.TeamDTO
+// @Failure      400   {object}  dto.ErrorResponse
+// @Router       /api/v2/teams [post]
+func (h *TeamV2Handler) CreateTeam(c *gin.Context) {
+	var req dto.CreateTeamRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	created, err := h.createTeamUseCase.Execute(dto.ToCreateTeamRequest(req))
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	c.Header("Location", "/api/v2/teams/"+url.PathEscape(created.TeamName))
+	respondJSON(c, http.StatusCreated, dto.ToTeamDTO(created))
+}
+
+// GetTeam godoc
+// @Summary      Получить команду
+// @Description  Возвращает команду с участниками и правилами
+// @Tags         Teams v2
+// @Produce      json
+// @Param        team_name      path      string  true  "Имя команды"
+// @Param        If-None-Match  header    string  false  "ETag, полученный ранее"
+// @Success      200            {object}  dto.TeamDTO
+// @Header       200            {string}  ETag  "Версия ресурса"
+// @Header       200            {string}  Last-Modified  "Время последнего изменения"
+// @Success      304            "Ресурс не изменился"
+// @Failure      400            {object}  dto.ErrorResponse
+// @Failure      404            {object}  dto.ErrorResponse
+// @Router       /api/v2/teams/{team_name} [get]
+func (h *TeamV2Handler) GetTeam(c *gin.Context) {
+	var path dto.TeamPath
+	if !bindURI(c, &path) {
+		return
+	}
+
+	found, err := h.getTeamUseCase.Execute(path.TeamName)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	if respondNotModified(c, found.Version, found.UpdatedAt) {
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToTeamDTO(found))
+}
+
+func (h *TeamV2Handler) RegisterRoutes(r *gin.RouterGroup) {
+	r.GET("/teams", h.ListTeams)
+	r.POST("/teams", h.CreateTeam)
+	r.GET("/teams/:team_name", h.GetTeam)
+}
@@ -10,49 +10,67 @@ import (
 )
 
 type UserHandler struct {
-	setActiveUseCase  *user.SetActiveUseCase
-	getReviewsUseCase *user.GetReviewsUseCase
+	setActiveUseCase    *user.SetActiveUseCase
+	getReviewsUseCase   *user.GetReviewsUseCase
+	setTagsUseCase      *user.SetTagsUseCase
+	getTagsUseCase      *user.GetTagsUseCase
+	setSeniorityUseCase *user.SetSeniorityUseCase
+	setScheduleUseCase  *user.SetScheduleUseCase
 }
 
 func NewUserHandler(
 	setActiveUseCase *user.SetActiveUseCase,
 	getReviewsUseCase *user.GetReviewsUseCase,
+	setTagsUseCase *user.SetTagsUseCase,
+	getTagsUseCase *user.GetTagsUseCase,
+	setSeniorityUseCase *user.SetSeniorityUseCase,
+	setScheduleUseCase *user.SetScheduleUseCase,
 ) *UserHandler {
 	return &UserHandler{
-		setActiveUseCase:  setActiveUseCase,
-		getReviewsUseCase: getReviewsUseCase,
+		setActiveUseCase:    setActiveUseCase,
+		getReviewsUseCase:   getReviewsUseCase,
+		setTagsUseCase:      setTagsUseCase,
+		getTagsUseCase:      getTagsUseCase,
+		setSeniorityUseCase: setSeniorityUseCase,
+		setScheduleUseCase:  setScheduleUseCase,
 	}
 }
 
 // SetActive godoc
 // @Summary      Установить флаг активности пользователя
-// @Description  Устанавливает флаг активности пользователя
+// @Description  Устанавливает флаг активности пользователя и применяет политику к его открытым ревью. При деактивации ревью переназначаются (on_deactivate=REASSIGN) или остаются с отметкой об устаревании (KEEP, по умолчанию). При возвращении отметки снимаются, а с on_reactivate=BACKFILL пользователь назначается ревьюером открытых PR своей команды, которым не хватает ревьюеров. Возвращает затронутые PR
 // @Tags         Users
 // @Accept       json
 // @Produce      json
-// @Param        request  body      dto.SetActiveRequest  true  "Данные пользователя"
-// @Success      200      {object}  dto.UserResponse
-// @Failure      404      {object}  dto.ErrorResponse
+// @Param        request   body      dto.SetActiveRequest  true  "Данные пользователя"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.SetActiveResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
 // @Router       /users/setIsActive [post]
 func (h *UserHandler) SetActive(c *gin.Context) {
 	var req dto.SetActiveRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
 		return
 	}
 
 	useCaseReq := dto.ToSetActiveRequest(req)
-	user, err := h.setActiveUseCase.Execute(useCaseReq)
+	useCaseReq.IfMatch = ifMatch
+	response, err := h.setActiveUseCase.Execute(useCaseReq)
 	if err != nil {
 		handleDomainError(c, err)
 		return
 	}
 
-	response := dto.UserResponse{
-		User: dto.ToUserDTO(user),
-	}
-
-	respondJSON(c, http.StatusOK, response)
+	setValidators(c, response.User.Version, response.User.UpdatedAt)
+	respondJSON(c, http.StatusOK, dto.ToSetActiveResponse(response))
 }
 
 // GetReviews godoc
@@ -91,7 +109,155 @@ func (h *UserHandler) GetReviews(c *gin.Context) {
 	respondJSON(c, http.StatusOK, response)
 }
 
+// SetTags godoc
+// @Summary      Установить теги экспертизы пользователя
+// @Description  Полностью заменяет теги экспертизы (например, go, postgres, frontend). Теги приводятся к нижнему регистру
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.SetTagsRequest  true  "Теги пользователя"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.UserTagsResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /users/setTags [post]
+func (h *UserHandler) SetTags(c *gin.Context) {
+	var req dto.SetTagsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToSetTagsRequest(req)
+	useCaseReq.IfMatch = ifMatch
+	user, err := h.setTagsUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	setValidators(c, user.Version, user.UpdatedAt)
+	respondJSON(c, http.StatusOK, dto.ToUserTagsResponse(user.UserID, user.Tags))
+}
+
+// GetTags godoc
+// @Summary      Получить теги экспертизы пользователя
+// @Description  Возвращает теги экспертизы пользователя
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        user_id  query     string  true  "Идентификатор пользователя"
+// @Success      200      {object}  dto.UserTagsResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Router       /users/getTags [get]
+func (h *UserHandler) GetTags(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", "user_id is required")
+		return
+	}
+
+	tags, err := h.getTagsUseCase.Execute(userID)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToUserTagsResponse(userID, tags))
+}
+
+// SetSeniority godoc
+// @Summary      Установить уровень пользователя
+// @Description  Устанавливает уровень пользователя: junior, middle, senior или lead
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.SetSeniorityRequest  true  "Уровень пользователя"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.UserResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /users/setSeniority [post]
+func (h *UserHandler) SetSeniority(c *gin.Context) {
+	var req dto.SetSeniorityRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToSetSeniorityRequest(req)
+	useCaseReq.IfMatch = ifMatch
+	user, err := h.setSeniorityUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.UserResponse{
+		User: dto.ToUserDTO(user),
+	}
+
+	setValidators(c, user.Version, user.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
+// SetSchedule godoc
+// @Summary      Установить часовой пояс и рабочее время пользователя
+// @Description  Устанавливает часовой пояс (IANA) и рабочие часы (пн-пт). Пустые work_start и work_end снимают ограничение
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        request   body      dto.SetScheduleRequest  true  "Расписание пользователя"
+// @Param        If-Match  header    string  false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.UserResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /users/setSchedule [post]
+func (h *UserHandler) SetSchedule(c *gin.Context) {
+	var req dto.SetScheduleRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToSetScheduleRequest(req)
+	useCaseReq.IfMatch = ifMatch
+	user, err := h.setScheduleUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	response := dto.UserResponse{
+		User: dto.ToUserDTO(user),
+	}
+
+	setValidators(c, user.Version, user.UpdatedAt)
+	respondJSON(c, http.StatusOK, response)
+}
+
 func (h *UserHandler) RegisterRoutes(r *gin.Engine) {
 	r.POST("/users/setIsActive", h.SetActive)
 	r.GET("/users/getReview", h.GetReviews)
+	r.POST("/users/setTags", h.SetTags)
+	r.GET("/users/getTags", h.GetTags)
+	r.POST("/users/setSeniority", h.SetSeniority)
+	r.POST("/users/setSchedule", h.SetSchedule)
 }
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/user"
+)
+
+// UserV2Handler — ресурс /api/v2/users.
+type UserV2Handler struct {
+	getUserUseCase    *user.GetUserUseCase
+	updateUserUseCase *user.UpdateUserUseCase
+}
+
+func NewUserV2Handler(getUserUseCase *user.GetUserUseCase, updateUserUseCase *user.UpdateUserUseCase) *UserV2Handler {
+	return &UserV2Handler{
+		getUserUseCase:    getUserUseCase,
+		updateUserUseCase: updateUserUseCase,
+	}
+}
+
+// GetUser godoc
+// @Summary      Получить пользователя
+// @Description  Возвращает пользователя. ETag меняется при любом изменении пользователя, включая теги
+// @Tags         Users v2
+// @Produce      json
+// @Param        user_id        path      string  true   "Идентификатор пользователя"
+// @Param        If-None-Match  header    string  false  "ETag, полученный ранее"
+// @Success      200            {object}  dto.UserDTO
+// @Header       200            {string}  ETag  "Версия ресурса"
+// @Header       200            {string}  Last-Modified  "Время последнего изменения"
+// @Success      304            "Ресурс не изменился"
+// @Failure      400            {object}  dto.ErrorResponse
+// @Failure      404            {object}  dto.ErrorResponse
+// @Router       /api/v2/users/{user_id} [get]
+func (h *UserV2Handler) GetUser(c *gin.Context) {
+	var path dto.UserPath
+	if !bindURI(c, &path) {
+		return
+	}
+
+	found, err := h.getUserUseCase.Execute(path.UserID)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	if respondNotModified(c, found.Version, found.UpdatedAt) {
+		return
+	}
+
+	respondJSON(c, http.StatusOK, dto.ToUserDTO(found))
+}
+
+// UpdateUser godoc
+// @Summary      Частично обновить пользователя
+// @Description  Меняет только переданные поля: is_active (с политиками on_deactivate и on_reactivate, как в /users/setIsActive) и seniority. Возвращает пользователя и затронутые открытые PR
+// @Tags         Users v2
+// @Accept       json
+// @Produce      json
+// @Param        user_id   path      string                 true   "Идентификатор пользователя"
+// @Param        request   body      dto.UpdateUserRequest  true   "Изменяемые поля"
+// @Param        If-Match  header    string                 false  "Ожидаемая версия ресурса (ETag)"
+// @Success      200       {object}  dto.SetActiveResponse
+// @Header       200       {string}  ETag  "Версия ресурса"
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      412       {object}  dto.ErrorResponse
+// @Router       /api/v2/users/{user_id} [patch]
+func (h *UserV2Handler) UpdateUser(c *gin.Context) {
+	var path dto.UserPath
+	if !bindURI(c, &path) {
+		return
+	}
+
+	var req dto.UpdateUserRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	ifMatch, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	useCaseReq := dto.ToUpdateUserRequest(path.UserID, req)
+	useCaseReq.IfMatch = ifMatch
+	response, err := h.updateUserUseCase.Execute(useCaseReq)
+	if err != nil {
+		handleDomainError(c, err)
+		return
+	}
+
+	setValidators(c, response.User.Version, response.User.UpdatedAt)
+	respondJSON(c, http.StatusOK, dto.ToSetActiveResponse(response))
+}
+
+func (h *UserV2Handler) RegisterRoutes(r *gin.RouterGroup) {
+	r.GET("/users/:user_id", h.GetUser)
+	r.PATCH("/users/:user_id", h.UpdateUser)
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/dto"
+)
+
+// Правила проверки DTO задаются тегами binding в dto/request.go. Кроме
+// встроенных правил validator доступно id — идентификатор команды,
+// пользователя или PR: буквы любого алфавита, цифры, '.', '_' и '-'.
+var idPattern = regexp.MustCompile(`^[\p{L}\p{N}._-]+$`)
+
+func init() {
+	engine, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+
+	// В ошибках поля называются так же, как в JSON, query и пути
+	engine.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"json", "form", "uri"} {
+			if name := strings.Split(field.Tag.Get(tag), ",")[0]; name != "" && name != "-" {
+				return name
+			}
+		}
+		return field.Name
+	})
+	engine.RegisterValidation("id", func(fl validator.FieldLevel) bool {
+		return idPattern.MatchString(fl.Field().String())
+	})
+}
+
+// bindJSON разбирает и проверяет тело запроса; при ошибке отвечает 400.
+func bindJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		respondInvalidRequest(c, err, "invalid request body")
+		return false
+	}
+	return true
+}
+
+// bindURI разбирает и проверяет параметры пути; при ошибке отвечает 400.
+func bindURI(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindUri(req); err != nil {
+		respondInvalidRequest(c, err, "invalid path parameters")
+		return false
+	}
+	return true
+}
+
+// respondInvalidRequest отвечает 400 INVALID_REQUEST. Если запрос разобран, но
+// не прошёл проверку, details.fields перечисляет каждое неверное поле.
+func respondInvalidRequest(c *gin.Context, err error, message string) {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		respondError(c, http.StatusBadRequest, "INVALID_REQUEST", message)
+		return
+	}
+
+	details := make([]dto.FieldErrorDTO, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		details = append(details, dto.FieldErrorDTO{
+			Field:   fieldPath(fieldError),
+			Rule:    fieldError.Tag(),
+			Message: fieldErrorMessage(fieldError),
+		})
+	}
+
+	c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+		Error: dto.ErrorDetail{
+			Code:    "INVALID_REQUEST",
+			Message: "request validation failed",
+			Details: map[string]interface{}{"fields": details},
+		},
+	})
+}
+
+// fieldPath — путь к полю без имени корневой структуры: members[1].user_id.
+func fieldPath(fieldError validator.FieldError) string {
+	namespace := fieldError.Namespace()
+	if separator := strings.Index(namespace, "."); separator >= 0 {
+		return namespace[separator+1:]
+	}
+	return namespace
+}
+
+func fieldErrorMessage(fieldError validator.FieldError) string {
+	isCollection := fieldError.Kind() == reflect.Slice || fieldError.Kind() == reflect.Map
+
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "id":
+		return "must contain only letters, digits, '.', '_' and '-'"
+	case "unique":
+		if fieldError.Param() != "" {
+			return "must not contain duplicate " + uniqueFieldName(fieldError)
+		}
+		return "must not contain duplicates"
+	case "max":
+		if isCollection {
+			return fmt.Sprintf("must contain at most %s items", fieldError.Param())
+		}
+		if fieldError.Kind() == reflect.String {
+			return fmt.Sprintf("must be at most %s characters long", fieldError.Param())
+		}
+		return "must be at most " + fieldError.Param()
+	case "min":
+		if isCollection && fieldError.Param() == "1" {
+			return "must not be empty"
+		}
+		if isCollection {
+			return fmt.Sprintf("must contain at least %s items", fieldError.Param())
+		}
+		if fieldError.Kind() == reflect.String {
+			return fmt.Sprintf("must be at least %s characters long", fieldError.Param())
+		}
+		return "must be at least " + fieldError.Param()
+	case "gt":
+		return "must be greater than " + fieldError.Param()
+	case "email":
+		return "must be a valid email address"
+	}
+	return "is invalid"
+}
+
+// uniqueFieldName переводит параметр unique=UserID в JSON-имя поля элемента.
+func uniqueFieldName(fieldError validator.FieldError) string {
+	elem := fieldError.Type().Elem()
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() == reflect.Struct {
+		if field, ok := elem.FieldByName(fieldError.Param()); ok {
+			if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+				return name
+			}
+		}
+	}
+	return fieldError.Param()
+}
@@ -0,0 +1,76 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+// domainCodes сопоставляет коды доменных ошибок статусам gRPC так же, как
+// каталог ошибок REST API — HTTP-статусам: 404 — NotFound, 400 —
+// InvalidArgument, 409 — FailedPrecondition, AlreadyExists или Aborted.
+var domainCodes = map[string]codes.Code{
+	domain.ErrNotFound.Code:                 codes.NotFound,
+	domain.ErrTeamExists.Code:               codes.AlreadyExists,
+	domain.ErrPRExists.Code:                 codes.AlreadyExists,
+	domain.ErrAlreadyAssigned.Code:          codes.AlreadyExists,
+	domain.ErrInvalidArgument.Code:          codes.InvalidArgument,
+	domain.ErrInvalidStatus.Code:            codes.InvalidArgument,
+	domain.ErrPRMerged.Code:                 codes.FailedPrecondition,
+	domain.ErrNotAssigned.Code:              codes.FailedPrecondition,
+	domain.ErrNoCandidate.Code:              codes.FailedPrecondition,
+	domain.ErrSeniorityRule.Code:            codes.FailedPrecondition,
+	domain.ErrReviewerIsAuthor.Code:         codes.FailedPrecondition,
+	domain.ErrReviewerInactive.Code:         codes.FailedPrecondition,
+	domain.ErrTeamMismatch.Code:             codes.FailedPrecondition,
+	domain.ErrPairingRule.Code:              codes.FailedPrecondition,
+	domain.ErrPreconditionFailed.Code:       codes.FailedPrecondition,
+	domain.ErrConcurrentUpdate.Code:         codes.Aborted,
+	domain.ErrIdempotencyKeyReused.Code:     codes.InvalidArgument,
+	domain.ErrIdempotencyKeyInProgress.Code: codes.Aborted,
+}
+
+// errorDomain — домен ErrorInfo, в котором уникальны доменные коды.
+const errorDomain = "pr-reviewer"
+
+// toStatus переводит ошибку сценария в статус gRPC. Как и в REST API, клиент
+// получает сообщение доменной ошибки, а код и подробности — в ErrorInfo
+// (Reason и Metadata). Остальные ошибки записываются в лог и скрываются за
+// Internal.
+func toStatus(err error) error {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		if code, ok := domainCodes[domainErr.Code]; ok {
+			return domainStatus(code, domainErr)
+		}
+	}
+
+	log.Printf("grpc: %v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func domainStatus(code codes.Code, domainErr *domain.DomainError) error {
+	info := &errdetails.ErrorInfo{
+		Reason: domainErr.Code,
+		Domain: errorDomain,
+	}
+	if len(domainErr.Details) > 0 {
+		info.Metadata = make(map[string]string, len(domainErr.Details))
+		for key, value := range domainErr.Details {
+			info.Metadata[key] = fmt.Sprint(value)
+		}
+	}
+
+	st, err := status.New(code, domainErr.Message).WithDetails(info)
+	if err != nil {
+		log.Printf("grpc: attach error details: %v", err)
+		return status.Error(code, domainErr.Message)
+	}
+	return st.Err()
+}
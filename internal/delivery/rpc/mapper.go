@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reviewerv1 "github.com/avito-tech-backend-autumn-2025/api/proto/reviewer/v1"
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func toTeamPB(team *domain.Team) *reviewerv1.Team {
+	members := make([]*reviewerv1.TeamMember, 0, len(team.Members))
+	for _, member := range team.Members {
+		members = append(members, &reviewerv1.TeamMember{
+			UserId:    member.UserID,
+			Username:  member.Username,
+			IsActive:  member.IsActive,
+			Seniority: string(member.Seniority),
+		})
+	}
+
+	return &reviewerv1.Team{
+		TeamName:      team.TeamName,
+		Members:       members,
+		FallbackTeams: team.FallbackTeams,
+	}
+}
+
+func toUserPB(user *domain.User) *reviewerv1.User {
+	return &reviewerv1.User{
+		UserId:    user.UserID,
+		Username:  user.Username,
+		TeamName:  user.TeamName,
+		IsActive:  user.IsActive,
+		Seniority: string(user.Seniority),
+	}
+}
+
+func toPullRequestPB(pr *domain.PullRequest) *reviewerv1.PullRequest {
+	message := &reviewerv1.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            string(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		Labels:            pr.Labels,
+		CreatedAt:         timestamppb.New(pr.CreatedAt),
+	}
+	if pr.MergedAt != nil {
+		message.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+
+	for _, reviewerID := range pr.AssignedReviewers {
+		if teamName := pr.FallbackTeams[reviewerID]; teamName != "" {
+			message.FallbackReviewers = append(message.FallbackReviewers, &reviewerv1.FallbackReviewer{
+				UserId:   reviewerID,
+				TeamName: teamName,
+			})
+		}
+		if _, ok := pr.StaleReviewers[reviewerID]; ok {
+			message.StaleReviewers = append(message.StaleReviewers, reviewerID)
+		}
+	}
+
+	return message
+}
+
+func toPullRequestShortPB(pr *domain.PullRequest) *reviewerv1.PullRequestShort {
+	return &reviewerv1.PullRequestShort{
+		PullRequestId:   pr.ID,
+		PullRequestName: pr.Name,
+		AuthorId:        pr.AuthorID,
+		Status:          string(pr.Status),
+	}
+}
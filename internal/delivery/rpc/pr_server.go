@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+
+	reviewerv1 "github.com/avito-tech-backend-autumn-2025/api/proto/reviewer/v1"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+)
+
+type PRServer struct {
+	reviewerv1.UnimplementedPullRequestServiceServer
+
+	createPRUseCase         *pr.CreatePRUseCase
+	mergePRUseCase          *pr.MergePRUseCase
+	reassignReviewerUseCase *pr.ReassignReviewerUseCase
+	addReviewerUseCase      *pr.AddReviewerUseCase
+	removeReviewerUseCase   *pr.RemoveReviewerUseCase
+}
+
+func NewPRServer(
+	createPRUseCase *pr.CreatePRUseCase,
+	mergePRUseCase *pr.MergePRUseCase,
+	reassignReviewerUseCase *pr.ReassignReviewerUseCase,
+	addReviewerUseCase *pr.AddReviewerUseCase,
+	removeReviewerUseCase *pr.RemoveReviewerUseCase,
+) *PRServer {
+	return &PRServer{
+		createPRUseCase:         createPRUseCase,
+		mergePRUseCase:          mergePRUseCase,
+		reassignReviewerUseCase: reassignReviewerUseCase,
+		addReviewerUseCase:      addReviewerUseCase,
+		removeReviewerUseCase:   removeReviewerUseCase,
+	}
+}
+
+func (s *PRServer) CreatePullRequest(ctx context.Context, req *reviewerv1.CreatePullRequestRequest) (*reviewerv1.PullRequest, error) {
+	created, err := s.createPRUseCase.Execute(pr.CreatePRRequest{
+		PRID:         req.GetPullRequestId(),
+		PRName:       req.GetPullRequestName(),
+		AuthorID:     req.GetAuthorId(),
+		ChangedFiles: req.GetChangedFiles(),
+		Labels:       req.GetLabels(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPullRequestPB(created), nil
+}
+
+func (s *PRServer) MergePullRequest(ctx context.Context, req *reviewerv1.MergePullRequestRequest) (*reviewerv1.PullRequest, error) {
+	merged, err := s.mergePRUseCase.Execute(pr.MergePRRequest{PRID: req.GetPullRequestId()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPullRequestPB(merged), nil
+}
+
+func (s *PRServer) ReassignReviewer(ctx context.Context, req *reviewerv1.ReassignReviewerRequest) (*reviewerv1.ReassignReviewerResponse, error) {
+	result, err := s.reassignReviewerUseCase.Execute(pr.ReassignReviewerRequest{
+		PRID:      req.GetPullRequestId(),
+		OldUserID: req.GetOldUserId(),
+		NewUserID: req.GetNewUserId(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &reviewerv1.ReassignReviewerResponse{
+		Pr:         toPullRequestPB(result.PR),
+		ReplacedBy: result.ReplacedBy,
+	}, nil
+}
+
+func (s *PRServer) AddReviewer(ctx context.Context, req *reviewerv1.ReviewerChangeRequest) (*reviewerv1.PullRequest, error) {
+	updated, err := s.addReviewerUseCase.Execute(pr.AddReviewerRequest{
+		PRID:   req.GetPullRequestId(),
+		UserID: req.GetUserId(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPullRequestPB(updated), nil
+}
+
+func (s *PRServer) RemoveReviewer(ctx context.Context, req *reviewerv1.ReviewerChangeRequest) (*reviewerv1.PullRequest, error) {
+	updated, err := s.removeReviewerUseCase.Execute(pr.RemoveReviewerRequest{
+		PRID:   req.GetPullRequestId(),
+		UserID: req.GetUserId(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPullRequestPB(updated), nil
+}
@@ -0,0 +1,19 @@
+package rpc
+
+import (
+	"google.golang.org/grpc"
+
+	reviewerv1 "github.com/avito-tech-backend-autumn-2025/api/proto/reviewer/v1"
+)
+
+// NewServer собирает gRPC-сервер. Серверы сервисов только переводят сообщения
+// в запросы тех же сценариев, что и HTTP-обработчики.
+func NewServer(teamServer *TeamServer, userServer *UserServer, prServer *PRServer, opts ...grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(opts...)
+
+	reviewerv1.RegisterTeamServiceServer(s, teamServer)
+	reviewerv1.RegisterUserServiceServer(s, userServer)
+	reviewerv1.RegisterPullRequestServiceServer(s, prServer)
+
+	return s
+}
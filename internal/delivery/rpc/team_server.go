@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+
+	reviewerv1 "github.com/avito-tech-backend-autumn-2025/api/proto/reviewer/v1"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/team"
+)
+
+type TeamServer struct {
+	reviewerv1.UnimplementedTeamServiceServer
+
+	createTeamUseCase *team.CreateTeamUseCase
+	getTeamUseCase    *team.GetTeamUseCase
+}
+
+func NewTeamServer(createTeamUseCase *team.CreateTeamUseCase, getTeamUseCase *team.GetTeamUseCase) *TeamServer {
+	return &TeamServer{
+		createTeamUseCase: createTeamUseCase,
+		getTeamUseCase:    getTeamUseCase,
+	}
+}
+
+func (s *TeamServer) CreateTeam(ctx context.Context, req *reviewerv1.CreateTeamRequest) (*reviewerv1.Team, error) {
+	members := make([]team.TeamMemberRequest, 0, len(req.GetMembers()))
+	for _, member := range req.GetMembers() {
+		members = append(members, team.TeamMemberRequest{
+			UserID:    member.GetUserId(),
+			Username:  member.GetUsername(),
+			IsActive:  member.GetIsActive(),
+			Seniority: member.GetSeniority(),
+		})
+	}
+
+	createdTeam, err := s.createTeamUseCase.Execute(team.CreateTeamRequest{
+		TeamName: req.GetTeamName(),
+		Members:  members,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toTeamPB(createdTeam), nil
+}
+
+func (s *TeamServer) GetTeam(ctx context.Context, req *reviewerv1.GetTeamRequest) (*reviewerv1.Team, error) {
+	foundTeam, err := s.getTeamUseCase.Execute(req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toTeamPB(foundTeam), nil
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+
+	reviewerv1 "github.com/avito-tech-backend-autumn-2025/api/proto/reviewer/v1"
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/user"
+)
+
+type UserServer struct {
+	reviewerv1.UnimplementedUserServiceServer
+
+	setActiveUseCase  *user.SetActiveUseCase
+	getReviewsUseCase *user.GetReviewsUseCase
+}
+
+func NewUserServer(setActiveUseCase *user.SetActiveUseCase, getReviewsUseCase *user.GetReviewsUseCase) *UserServer {
+	return &UserServer{
+		setActiveUseCase:  setActiveUseCase,
+		getReviewsUseCase: getReviewsUseCase,
+	}
+}
+
+func (s *UserServer) SetIsActive(ctx context.Context, req *reviewerv1.SetIsActiveRequest) (*reviewerv1.SetIsActiveResponse, error) {
+	result, err := s.setActiveUseCase.Execute(user.SetActiveRequest{
+		UserID:       req.GetUserId(),
+		IsActive:     req.GetIsActive(),
+		OnDeactivate: req.GetOnDeactivate(),
+		OnReactivate: req.GetOnReactivate(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &reviewerv1.SetIsActiveResponse{User: toUserPB(result.User)}
+	for _, affected := range result.AffectedPRs {
+		affectedPB := &reviewerv1.AffectedPullRequest{
+			PullRequestId:  affected.PRID,
+			Action:         affected.Action,
+			ReplacedBy:     affected.ReplacedBy,
+			AddedReviewers: affected.AddedReviewers,
+		}
+		if affected.Err != nil {
+			affectedPB.Error = domain.CodeOf(affected.Err)
+		}
+		response.AffectedPullRequests = append(response.AffectedPullRequests, affectedPB)
+	}
+
+	return response, nil
+}
+
+func (s *UserServer) GetReviews(ctx context.Context, req *reviewerv1.GetReviewsRequest) (*reviewerv1.GetReviewsResponse, error) {
+	prs, err := s.getReviewsUseCase.Execute(req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &reviewerv1.GetReviewsResponse{UserId: req.GetUserId()}
+	for _, pr := range prs {
+		response.PullRequests = append(response.PullRequests, toPullRequestShortPB(pr))
+	}
+
+	return response, nil
+}
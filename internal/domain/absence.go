@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// Absence — период отсутствия пользователя (отпуск, больничный). Границы
+// StartDate и EndDate включаются и хранятся как даты без времени.
+type Absence struct {
+	ID        int64
+	UserID    string
+	StartDate time.Time
+	EndDate   time.Time
+	Reason    string
+}
+
+func NewAbsence(userID string, startDate, endDate time.Time, reason string) (*Absence, error) {
+	startDate = DateOf(startDate)
+	endDate = DateOf(endDate)
+
+	if endDate.Before(startDate) {
+		return nil, InvalidArgument("end_date", "must not be before start_date")
+	}
+
+	return &Absence{
+		UserID:    userID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    reason,
+	}, nil
+}
+
+func (a *Absence) Covers(at time.Time) bool {
+	day := DateOf(at)
+	return !day.Before(a.StartDate) && !day.After(a.EndDate)
+}
+
+// DateOf отбрасывает время, оставляя календарную дату в UTC.
+func DateOf(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,47 @@
+package domain
+
+// DeactivationPolicy — что делать с открытыми ревью пользователя, которого
+// сделали неактивным.
+type DeactivationPolicy string
+
+const (
+	// DeactivationKeep оставляет ревью за пользователем и помечает их устаревшими
+	DeactivationKeep DeactivationPolicy = "KEEP"
+	// DeactivationReassign сразу переназначает ревью; то, что переназначить
+	// не удалось, помечается устаревшим
+	DeactivationReassign DeactivationPolicy = "REASSIGN"
+)
+
+// ReactivationPolicy — что делать, когда пользователь снова становится активным.
+type ReactivationPolicy string
+
+const (
+	ReactivationNone ReactivationPolicy = "NONE"
+	// ReactivationBackfill добирает ревьюеров открытым PR, чтобы вернувшийся
+	// пользователь мог их получить
+	ReactivationBackfill ReactivationPolicy = "BACKFILL"
+)
+
+// ParseDeactivationPolicy разбирает политику; пустое значение — KEEP.
+func ParseDeactivationPolicy(value string) (DeactivationPolicy, error) {
+	switch policy := DeactivationPolicy(value); policy {
+	case "":
+		return DeactivationKeep, nil
+	case DeactivationKeep, DeactivationReassign:
+		return policy, nil
+	default:
+		return "", InvalidArgument("on_deactivate", "must be KEEP or REASSIGN")
+	}
+}
+
+// ParseReactivationPolicy разбирает политику; пустое значение — NONE.
+func ParseReactivationPolicy(value string) (ReactivationPolicy, error) {
+	switch policy := ReactivationPolicy(value); policy {
+	case "":
+		return ReactivationNone, nil
+	case ReactivationNone, ReactivationBackfill:
+		return policy, nil
+	default:
+		return "", InvalidArgument("on_reactivate", "must be NONE or BACKFILL")
+	}
+}
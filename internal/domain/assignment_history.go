@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+type AssignmentAction string
+
+const (
+	ActionAssigned   AssignmentAction = "ASSIGNED"
+	ActionReassigned AssignmentAction = "REASSIGNED"
+	// ActionRemoved — ревьюер снят с PR без замены
+	ActionRemoved AssignmentAction = "REMOVED"
+)
+
+// AssignmentRecord — запись истории назначений. Seed позволяет воспроизвести
+// случайный выбор, приведший к назначению.
+type AssignmentRecord struct {
+	ID                 int64
+	PRID               string
+	Action             AssignmentAction
+	ReviewerID         string
+	ReplacedReviewerID string
+	Seed               int64
+	CreatedAt          time.Time
+}
+
+func NewAssignmentRecord(prID string, action AssignmentAction, reviewerID string, seed int64, createdAt time.Time) *AssignmentRecord {
+	return &AssignmentRecord{
+		PRID:       prID,
+		Action:     action,
+		ReviewerID: reviewerID,
+		Seed:       seed,
+		CreatedAt:  createdAt,
+	}
+}
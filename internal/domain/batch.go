@@ -0,0 +1,26 @@
+package domain
+
+// BatchMode — что делать с пакетом операций, если один из элементов не
+// выполнился.
+type BatchMode string
+
+const (
+	// BatchBestEffort сохраняет успешные элементы; неудачный элемент
+	// откатывается один
+	BatchBestEffort BatchMode = "BEST_EFFORT"
+	// BatchAllOrNothing выполняет пакет одной транзакцией и откатывает его
+	// целиком на первой ошибке
+	BatchAllOrNothing BatchMode = "ALL_OR_NOTHING"
+)
+
+// ParseBatchMode разбирает режим; пустое значение — BEST_EFFORT.
+func ParseBatchMode(value string) (BatchMode, error) {
+	switch mode := BatchMode(value); mode {
+	case "":
+		return BatchBestEffort, nil
+	case BatchBestEffort, BatchAllOrNothing:
+		return mode, nil
+	default:
+		return "", InvalidArgument("mode", "must be BEST_EFFORT or ALL_OR_NOTHING")
+	}
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// Clock абстрагирует текущее время, чтобы назначение можно было
+// детерминированно тестировать.
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock всегда возвращает одно и то же время.
+type FixedClock struct {
+	Time time.Time
+}
+
+func (c FixedClock) Now() time.Time {
+	return c.Time
+}
@@ -2,34 +2,117 @@ package domain
 
 import "errors"
 
+// Ошибки домена. Сообщения — для клиента; подробности конкретного случая
+// добавляются через WithDetails, причина — через Wrap.
 var (
-	ErrTeamExists      = errors.New("TEAM_EXISTS")
-	ErrPRExists        = errors.New("PR_EXISTS")
-	ErrPRMerged        = errors.New("PR_MERGED")
-	ErrNotAssigned     = errors.New("NOT_ASSIGNED")
-	ErrNoCandidate     = errors.New("NO_CANDIDATE")
-	ErrNotFound        = errors.New("NOT_FOUND")
-	ErrInvalidStatus   = errors.New("INVALID_STATUS")
-	ErrInvalidArgument = errors.New("INVALID_ARGUMENT")
+	ErrTeamExists       = NewDomainError("TEAM_EXISTS", "team_name already exists")
+	ErrPRExists         = NewDomainError("PR_EXISTS", "PR id already exists")
+	ErrPRMerged         = NewDomainError("PR_MERGED", "cannot change a merged PR")
+	ErrNotAssigned      = NewDomainError("NOT_ASSIGNED", "reviewer is not assigned to this PR")
+	ErrNoCandidate      = NewDomainError("NO_CANDIDATE", "no active replacement candidate in team")
+	ErrNotFound         = NewDomainError("NOT_FOUND", "resource not found")
+	ErrInvalidStatus    = NewDomainError("INVALID_STATUS", "invalid PR status")
+	ErrInvalidArgument  = NewDomainError("INVALID_ARGUMENT", "invalid argument")
+	ErrSeniorityRule    = NewDomainError("SENIORITY_RULE_UNSATISFIED", "not enough active reviewers of required seniority")
+	ErrAlreadyAssigned  = NewDomainError("ALREADY_ASSIGNED", "user is already assigned to this PR")
+	ErrReviewerIsAuthor = NewDomainError("REVIEWER_IS_AUTHOR", "author cannot review own PR")
+	ErrReviewerInactive = NewDomainError("REVIEWER_INACTIVE", "reviewer is inactive or absent")
+	ErrTeamMismatch     = NewDomainError("TEAM_MISMATCH", "reviewer is not a member of the required team")
+	ErrPairingRule      = NewDomainError("PAIRING_RULE_VIOLATION", "reviewer cannot be paired with the author")
+
+	// ErrPreconditionFailed — версия, которую видел клиент, устарела
+	ErrPreconditionFailed = NewDomainError("PRECONDITION_FAILED", "resource version does not match If-Match")
+	// ErrConcurrentUpdate — сущность изменили между чтением и записью
+	ErrConcurrentUpdate = NewDomainError("CONCURRENT_UPDATE", "resource was modified concurrently, retry the request")
+
+	ErrIdempotencyKeyReused     = NewDomainError("IDEMPOTENCY_KEY_REUSED", "Idempotency-Key was already used with a different request")
+	ErrIdempotencyKeyInProgress = NewDomainError("IDEMPOTENCY_KEY_IN_PROGRESS", "request with this Idempotency-Key is still in progress")
 )
 
+// Details — машиночитаемые подробности ошибки: идентификаторы сущностей,
+// ожидаемые и фактические значения.
+type Details map[string]interface{}
+
+// DomainError — ошибка бизнес-правила с кодом, по которому её различают клиенты.
+// Ошибки сравниваются по коду: errors.Is(err, ErrNotFound) верно для любой
+// ошибки с кодом NOT_FOUND, в том числе с подробностями и обёрнутой.
 type DomainError struct {
 	Code    string
 	Message string
+	Details Details
 	Err     error
 }
 
-func (e *DomainError) Error() string {
-	if e.Message != "" {
-		return e.Message
-	}
-	return e.Err.Error()
-}
-
 func NewDomainError(code, message string) *DomainError {
 	return &DomainError{
 		Code:    code,
 		Message: message,
-		Err:     errors.New(code),
 	}
 }
+
+// Error возвращает код, а для ошибки с причиной — код и причину.
+func (e *DomainError) Error() string {
+	if e.Err == nil {
+		return e.Code
+	}
+	return e.Code + ": " + e.Err.Error()
+}
+
+func (e *DomainError) Unwrap() error {
+	return e.Err
+}
+
+func (e *DomainError) Is(target error) bool {
+	other, ok := target.(*DomainError)
+	return ok && other.Code == e.Code
+}
+
+// WithDetails возвращает копию ошибки с добавленными подробностями.
+func (e *DomainError) WithDetails(details Details) *DomainError {
+	merged := make(Details, len(e.Details)+len(details))
+	for key, value := range e.Details {
+		merged[key] = value
+	}
+	for key, value := range details {
+		merged[key] = value
+	}
+
+	copied := *e
+	copied.Details = merged
+	return &copied
+}
+
+// Wrap возвращает копию ошибки с причиной. Причина видна в логах и через
+// errors.Is/As, но не клиенту.
+func (e *DomainError) Wrap(cause error) *DomainError {
+	copied := *e
+	copied.Err = cause
+	return &copied
+}
+
+// CodeOf возвращает код доменной ошибки или INTERNAL_ERROR для остальных.
+func CodeOf(err error) string {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.Code
+	}
+	return "INTERNAL_ERROR"
+}
+
+// Сущности в подробностях NOT_FOUND.
+const (
+	EntityTeam        = "team"
+	EntityUser        = "user"
+	EntityPullRequest = "pull_request"
+	EntityAbsence     = "absence"
+)
+
+// NotFound — ErrNotFound с типом и идентификатором ненайденной сущности.
+func NotFound(entity string, id interface{}) *DomainError {
+	return ErrNotFound.WithDetails(Details{"entity": entity, "id": id})
+}
+
+// InvalidArgument — ErrInvalidArgument с полем запроса и причиной отказа.
+func InvalidArgument(field, reason string) *DomainError {
+	return ErrInvalidArgument.WithDetails(Details{"field": field, "reason": reason})
+}
@@ -0,0 +1,90 @@
+package domain
+
+// FairnessPolicy ограничивает перекос нагрузки внутри команды: среди Window
+// последних назначений число ревью у участников должно различаться не больше
+// чем на MaxSkew. Нулевой Window отключает ротацию.
+type FairnessPolicy struct {
+	Window  int
+	MaxSkew int
+}
+
+func (p FairnessPolicy) Enabled() bool {
+	return p.Window > 0 && p.MaxSkew > 0
+}
+
+// rotation — нагрузка участников команды в скользящем окне последних назначений.
+type rotation struct {
+	counts  map[string]int
+	lastAt  map[string]int
+	floor   int
+	ceiling int
+	maxSkew int
+}
+
+// newRotation считает нагрузку по последним recent назначениям (от старых к
+// новым). slots — сколько назначений будет добавлено: столько же старых выпадет
+// из окна, поэтому они не учитываются. Границы берутся по всем members, включая
+// автора PR.
+func (p FairnessPolicy) newRotation(recent []string, members []*User, slots int) *rotation {
+	if !p.Enabled() {
+		return nil
+	}
+
+	keep := p.Window - slots
+	if keep < 0 {
+		keep = 0
+	}
+	if len(recent) > keep {
+		recent = recent[len(recent)-keep:]
+	}
+
+	counts := make(map[string]int)
+	lastAt := make(map[string]int)
+	for i, userID := range recent {
+		counts[userID]++
+		lastAt[userID] = i + 1
+	}
+
+	load := &rotation{counts: counts, lastAt: lastAt, maxSkew: p.MaxSkew}
+	for i, member := range members {
+		count := counts[member.UserID]
+		if i == 0 || count < load.floor {
+			load.floor = count
+		}
+		if count > load.ceiling {
+			load.ceiling = count
+		}
+	}
+
+	return load
+}
+
+// priority упорядочивает кандидатов по нагрузке: меньше — раньше.
+// Отрицательный — пользователь так отстал, что без назначения перекос превысит
+// MaxSkew; 0 — назначение допустимо; положительный — на сколько назначение
+// превысит перекос. Кандидаты с одинаковым приоритетом равноправны.
+func (r *rotation) priority(user *User) int {
+	if r == nil {
+		return 0
+	}
+
+	count := r.counts[user.UserID]
+	if behind := count - (r.ceiling - r.maxSkew); behind < 0 {
+		return behind
+	}
+
+	over := count - r.floor - r.maxSkew + 1
+	if over < 0 {
+		return 0
+	}
+	return over
+}
+
+// lastAssigned — позиция последнего назначения пользователя в окне; 0, если
+// назначений не было. При равной нагрузке раньше идёт тот, кто дольше ждал.
+func (r *rotation) lastAssigned(user *User) int {
+	if r == nil {
+		return 0
+	}
+	return r.lastAt[user.UserID]
+}
@@ -0,0 +1,164 @@
+package domain_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func TestReviewerAssigner_Fairness(t *testing.T) {
+	clock := domain.FixedClock{Time: time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)}
+
+	members := make([]*domain.User, 0, 8)
+	for i := 1; i <= 8; i++ {
+		members = append(members, domain.NewUser(fmt.Sprintf("u%d", i), fmt.Sprintf("user%d", i), "backend", true))
+	}
+	team := domain.NewTeam("backend", members)
+
+	const prCount = 5000
+
+	// simulate создаёт prCount PR и после каждого передаёт в check окно
+	// последних назначений и само назначение.
+	simulate := func(t *testing.T, policy domain.FairnessPolicy, author func(i int) *domain.User, check func(window []string, author *domain.User, assigned []string)) {
+		assigner := domain.NewReviewerAssigner(clock, domain.NewRandomSource(42), 24*time.Hour, policy)
+
+		var history []string
+		for i := 0; i < prCount; i++ {
+			prAuthor := author(i)
+			assignment, err := assigner.AssignReviewers(domain.AssignmentRequest{
+				Team:              team,
+				Author:            prAuthor,
+				MaxReviewers:      2,
+				RecentReviewerIDs: lastN(history, policy.Window),
+			})
+			require.NoError(t, err)
+			require.Len(t, assignment.ReviewerIDs, 2)
+
+			check(lastN(history, policy.Window-2), prAuthor, assignment.ReviewerIDs)
+			history = append(history, assignment.ReviewerIDs...)
+		}
+	}
+
+	// Тест проверяет гарантию ротации на тысячах PR, когда авторы сменяют друг друга.
+	// Ожидается: в любом окне последних назначений нагрузка участников различается не больше MaxSkew.
+	t.Run("Rotating authors - bounded skew in every window", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			policy domain.FairnessPolicy
+		}{
+			{name: "skew 1", policy: domain.FairnessPolicy{Window: 100, MaxSkew: 1}},
+			{name: "skew 2", policy: domain.FairnessPolicy{Window: 100, MaxSkew: 2}},
+			{name: "short window", policy: domain.FairnessPolicy{Window: 20, MaxSkew: 1}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				seen := 0
+				simulate(t, tt.policy, func(i int) *domain.User { return members[i%len(members)] },
+					func(window []string, author *domain.User, assigned []string) {
+						seen += len(assigned)
+						if seen < tt.policy.Window {
+							return
+						}
+						counts := countAssignments(append(window, assigned...), members)
+						require.LessOrEqual(t, spreadOf(counts), tt.policy.MaxSkew, "counts %v", counts)
+					})
+			})
+		}
+	})
+
+	// Тест проверяет ротацию при случайных авторах: автора назначить нельзя,
+	// поэтому он может временно отстать, но остальных ротация не обходит.
+	// Ожидается: выбранный ревьюер никогда не загружен на MaxSkew больше пропущенного кандидата.
+	t.Run("Random authors - never skips less loaded candidate", func(t *testing.T) {
+		policy := domain.FairnessPolicy{Window: 100, MaxSkew: 2}
+		r := rand.New(rand.NewSource(7))
+
+		simulate(t, policy, func(int) *domain.User { return members[r.Intn(len(members))] },
+			func(window []string, author *domain.User, assigned []string) {
+				load := make(map[string]int)
+				for _, userID := range window {
+					load[userID]++
+				}
+
+				picked := make(map[string]bool)
+				for _, userID := range assigned {
+					picked[userID] = true
+				}
+
+				for _, reviewerID := range assigned {
+					for _, member := range members {
+						if member.UserID == author.UserID || picked[member.UserID] {
+							continue
+						}
+						require.Less(t, load[reviewerID]-load[member.UserID], policy.MaxSkew,
+							"%s picked over %s", reviewerID, member.UserID)
+					}
+				}
+			})
+	})
+
+	// Тест проверяет, что без ротации назначение остаётся чисто случайным.
+	// Ожидается: на длинной серии перекос нагрузки в окне заметно превышает MaxSkew.
+	t.Run("Disabled - random selection drifts", func(t *testing.T) {
+		worst := 0
+		assigner := domain.NewReviewerAssigner(clock, domain.NewRandomSource(42), 24*time.Hour, domain.FairnessPolicy{})
+		var history []string
+		for i := 0; i < prCount; i++ {
+			assignment, err := assigner.AssignReviewers(domain.AssignmentRequest{Team: team, Author: members[i%len(members)], MaxReviewers: 2})
+			require.NoError(t, err)
+			history = append(history, assignment.ReviewerIDs...)
+			if len(history) >= 100 {
+				if spread := spreadOf(countAssignments(lastN(history, 100), members)); spread > worst {
+					worst = spread
+				}
+			}
+		}
+		assert.Greater(t, worst, 2)
+	})
+}
+
+func lastN(ids []string, n int) []string {
+	if n < 0 {
+		n = 0
+	}
+	if len(ids) > n {
+		return ids[len(ids)-n:]
+	}
+	return ids
+}
+
+func countAssignments(ids []string, members []*domain.User) []int {
+	load := make(map[string]int)
+	for _, userID := range ids {
+		load[userID]++
+	}
+
+	counts := make([]int, 0, len(members))
+	for _, member := range members {
+		counts = append(counts, load[member.UserID])
+	}
+	return counts
+}
+
+func spreadOf(counts []int) int {
+	if len(counts) == 0 {
+		return 0
+	}
+	min, max := counts[0], counts[0]
+	for _, count := range counts {
+		if count < min {
+			min = count
+		}
+		if count > max {
+			max = count
+		}
+	}
+	return max - min
+}
@@ -0,0 +1,49 @@
+package domain
+
+// fallbackPool — кандидаты из запасных команд, к которым обращаются, когда
+// домашняя команда не может дать нужное число ревьюеров.
+type fallbackPool struct {
+	candidates []*User
+	teamOf     map[string]string
+}
+
+// needsFallback сообщает, хватит ли домашних кандидатов, чтобы заполнить места
+// и выполнить правило старшинства.
+func needsFallback(req AssignmentRequest, reviewers, ranked []*User) bool {
+	if len(req.FallbackTeams) == 0 {
+		return false
+	}
+	if len(reviewers)+len(ranked) < req.MaxReviewers {
+		return true
+	}
+
+	rule := req.Team.SeniorityRule
+	return rule != nil && rule.MinReviewers-rule.CountSatisfying(reviewers) > rule.CountSatisfying(ranked)
+}
+
+// collectFallback обходит запасные команды по порядку и ранжирует кандидатов
+// внутри каждой: участник первой запасной команды всегда идёт раньше второй.
+func collectFallback(teams []*Team, authorID string, rules PairingRules, assigned map[string]bool, ranking *ranking, log *decisionLog) *fallbackPool {
+	pool := &fallbackPool{teamOf: make(map[string]string)}
+
+	for _, team := range teams {
+		var candidates []*User
+		for _, member := range team.Members {
+			switch {
+			case member.UserID == authorID || assigned[member.UserID] || pool.teamOf[member.UserID] != "":
+				continue
+			case !member.IsAvailableAt(ranking.now):
+				log.set(member.UserID, CandidateExcluded, unavailableReason(member))
+			case rules.Find(PairingNever, authorID, member.UserID) != nil:
+				log.set(member.UserID, CandidateExcluded, string(PairingNever))
+			default:
+				candidates = append(candidates, member)
+				pool.teamOf[member.UserID] = team.TeamName
+			}
+		}
+
+		pool.candidates = append(pool.candidates, ranking.rank(candidates)...)
+	}
+
+	return pool
+}
@@ -0,0 +1,162 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func TestReviewerAssigner_FallbackTeams(t *testing.T) {
+	clock := domain.FixedClock{Time: time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)}
+	assigner := domain.NewReviewerAssigner(clock, domain.NewRandomSource(42), 24*time.Hour, domain.FairnessPolicy{})
+
+	author := domain.NewUser("u1", "Alice", "payments", true)
+	home := domain.NewTeam("payments", []*domain.User{
+		author,
+		domain.NewUser("u2", "Bob", "payments", false),
+		domain.NewUser("u3", "Charlie", "payments", true),
+	})
+	platform := domain.NewTeam("platform", []*domain.User{
+		domain.NewUser("p1", "Peter", "platform", false),
+		domain.NewUser("p2", "Paul", "platform", true),
+	})
+	infra := domain.NewTeam("infra", []*domain.User{
+		domain.NewUser("i1", "Ivan", "infra", true),
+		domain.NewUser("i2", "Irene", "infra", true),
+	})
+
+	senior := domain.NewUser("i3", "Iris", "infra", true)
+	senior.Seniority = domain.SenioritySenior
+	seniorInfra := domain.NewTeam("infra", append([]*domain.User{senior}, infra.Members...))
+
+	ruled := domain.NewTeam("payments", home.Members)
+	ruled.SeniorityRule = domain.NewSeniorityRule(1, domain.SenioritySenior)
+
+	// Тест проверяет, что запасные команды добирают только недостающие места и по порядку.
+	// Ожидается: сначала свои участники, затем первая запасная команда с причиной FALLBACK_TEAM;
+	// если своих хватает, запасные команды не упоминаются в решениях; правило старшинства
+	// может выполнить запасная команда, а без неё — SENIORITY_RULE_UNSATISFIED.
+	t.Run("AssignReviewers", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			team          *domain.Team
+			maxReviewers  int
+			fallbackTeams []*domain.Team
+			want          []string
+			wantFallback  map[string]string
+			wantReasons   map[string]string
+			wantDecided   []string
+			wantErr       error
+		}{
+			{
+				name:          "home first, then fallback in order",
+				team:          home,
+				maxReviewers:  2,
+				fallbackTeams: []*domain.Team{platform, infra},
+				want:          []string{"u3", "p2"},
+				wantFallback:  map[string]string{"p2": "platform"},
+				wantReasons:   map[string]string{"p2": "FALLBACK_TEAM", "p1": "INACTIVE", "i1": "RANKED_LOWER"},
+			},
+			{
+				name:          "fallback not consulted when home suffices",
+				team:          home,
+				maxReviewers:  1,
+				fallbackTeams: []*domain.Team{platform, infra},
+				want:          []string{"u3"},
+				wantDecided:   []string{"u1", "u2", "u3"},
+			},
+			{
+				name:         "seniority unsatisfied without fallback",
+				team:         ruled,
+				maxReviewers: 2,
+				wantErr:      domain.ErrSeniorityRule,
+			},
+			{
+				name:          "seniority satisfied by fallback",
+				team:          ruled,
+				maxReviewers:  2,
+				fallbackTeams: []*domain.Team{seniorInfra},
+				want:          []string{"i3", "u3"},
+				wantFallback:  map[string]string{"i3": "infra"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assignment, err := assigner.AssignReviewers(domain.AssignmentRequest{
+					Team:          tt.team,
+					Author:        author,
+					MaxReviewers:  tt.maxReviewers,
+					FallbackTeams: tt.fallbackTeams,
+				})
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.want, assignment.ReviewerIDs)
+				if tt.wantFallback == nil {
+					assert.Empty(t, assignment.FallbackTeams)
+				} else {
+					assert.Equal(t, tt.wantFallback, assignment.FallbackTeams)
+				}
+
+				reasons := make(map[string]string)
+				for _, decision := range assignment.Decisions {
+					reasons[decision.UserID] = decision.Reason
+				}
+				for userID, reason := range tt.wantReasons {
+					assert.Equal(t, reason, reasons[userID], userID)
+				}
+				if tt.wantDecided != nil {
+					for _, decision := range assignment.Decisions {
+						assert.Contains(t, tt.wantDecided, decision.UserID)
+					}
+				}
+			})
+		}
+	})
+
+	// Тест проверяет поиск замены в запасных командах.
+	// Ожидается: без запасных команд NO_CANDIDATE, с ними — замена из первой команды, где есть кандидат.
+	t.Run("FindReplacementCandidate - falls back when home is exhausted", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			fallbackTeams []*domain.Team
+			want          string
+			wantTeam      string
+			wantErr       error
+		}{
+			{name: "no fallback teams", wantErr: domain.ErrNoCandidate},
+			{name: "first team with a candidate", fallbackTeams: []*domain.Team{platform, infra}, want: "p2", wantTeam: "platform"},
+			{name: "skips exhausted fallback", fallbackTeams: []*domain.Team{domain.NewTeam("empty", nil), infra}, wantTeam: "infra"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				replacement, err := assigner.FindReplacementCandidate(domain.ReplacementRequest{
+					Team:           home,
+					AuthorID:       "u1",
+					OldReviewer:    home.Members[2],
+					Reviewers:      []*domain.User{home.Members[2]},
+					ExcludeUserIDs: []string{"u1", "u3"},
+					FallbackTeams:  tt.fallbackTeams,
+				})
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				if tt.want != "" {
+					assert.Equal(t, tt.want, replacement.Reviewer.UserID)
+				}
+				assert.Equal(t, tt.wantTeam, replacement.FallbackTeam)
+			})
+		}
+	})
+}
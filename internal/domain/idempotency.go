@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+)
+
+// IdempotencyRecord — запрос, выполненный с заголовком Idempotency-Key.
+// Пока запрос выполняется, ответ не сохранён и Completed = false.
+type IdempotencyRecord struct {
+	Key         string
+	RequestHash string
+	Completed   bool
+	StatusCode  int
+	Headers     http.Header
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func NewIdempotencyRecord(key, requestHash string, now time.Time, ttl time.Duration) *IdempotencyRecord {
+	return &IdempotencyRecord{
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+}
+
+// Complete сохраняет ответ, который будет повторяться для запросов с тем же ключом.
+func (r *IdempotencyRecord) Complete(statusCode int, headers http.Header, body []byte) {
+	r.Completed = true
+	r.StatusCode = statusCode
+	r.Headers = headers
+	r.Body = body
+}
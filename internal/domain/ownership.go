@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"path"
+	"strings"
+)
+
+type OwnershipRule struct {
+	Pattern  string
+	TeamName string
+	UserIDs  []string
+}
+
+func NewOwnershipRule(pattern, teamName string, userIDs []string) *OwnershipRule {
+	return &OwnershipRule{
+		Pattern:  pattern,
+		TeamName: teamName,
+		UserIDs:  userIDs,
+	}
+}
+
+// Matches проверяет путь по правилам CODEOWNERS: шаблон без "/" ищется в любой
+// директории, "/" в конце означает директорию, "**" — любое число сегментов.
+// Шаблон без wildcard в последнем сегменте распространяется на всё содержимое
+// совпавшей директории, а "docs/*" — только на файлы непосредственно в docs.
+func (r *OwnershipRule) Matches(filePath string) bool {
+	pattern := strings.TrimSpace(r.Pattern)
+	if pattern == "" {
+		return false
+	}
+
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	if !strings.Contains(strings.TrimSuffix(pattern, "/**"), "/") {
+		pattern = "**/" + pattern
+	}
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	patternSegments := strings.Split(pattern, "/")
+	pathSegments := strings.Split(strings.Trim(filePath, "/"), "/")
+
+	if matchSegments(patternSegments, pathSegments) {
+		return true
+	}
+
+	if strings.ContainsAny(patternSegments[len(patternSegments)-1], "*?[") {
+		return false
+	}
+
+	for i := len(pathSegments) - 1; i > 0; i-- {
+		if matchSegments(patternSegments, pathSegments[:i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	ok, err := path.Match(pattern[0], segments[0])
+	if err != nil || !ok {
+		return false
+	}
+
+	return matchSegments(pattern[1:], segments[1:])
+}
+
+type FileOwnership struct {
+	File string
+	Rule *OwnershipRule
+}
+
+type OwnershipMatch struct {
+	Rule  *OwnershipRule
+	Files []string
+}
+
+// ExplainOwnership возвращает для каждого файла последнее подходящее правило,
+// как это делает CODEOWNERS. Для файлов без владельца Rule равен nil.
+func ExplainOwnership(rules []*OwnershipRule, files []string) []FileOwnership {
+	result := make([]FileOwnership, 0, len(files))
+	for _, file := range files {
+		var matched *OwnershipRule
+		for _, rule := range rules {
+			if rule.Matches(file) {
+				matched = rule
+			}
+		}
+		result = append(result, FileOwnership{File: file, Rule: matched})
+	}
+
+	return result
+}
+
+// MatchOwnership группирует файлы по сработавшим правилам в порядке правил.
+func MatchOwnership(rules []*OwnershipRule, files []string) []OwnershipMatch {
+	filesByRule := make(map[*OwnershipRule][]string)
+	for _, ownership := range ExplainOwnership(rules, files) {
+		if ownership.Rule != nil {
+			filesByRule[ownership.Rule] = append(filesByRule[ownership.Rule], ownership.File)
+		}
+	}
+
+	var matches []OwnershipMatch
+	for _, rule := range rules {
+		if matchedFiles, ok := filesByRule[rule]; ok {
+			matches = append(matches, OwnershipMatch{Rule: rule, Files: matchedFiles})
+		}
+	}
+
+	return matches
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+type PairingRuleType string
+
+const (
+	PairingNever         PairingRuleType = "NEVER_PAIR"
+	PairingPrefer        PairingRuleType = "PREFER_PAIR"
+	PairingAlwaysInclude PairingRuleType = "ALWAYS_INCLUDE"
+)
+
+func ParsePairingRuleType(value string) (PairingRuleType, error) {
+	switch ruleType := PairingRuleType(value); ruleType {
+	case PairingNever, PairingPrefer, PairingAlwaysInclude:
+		return ruleType, nil
+	default:
+		return "", InvalidArgument("type", "must be NEVER_PAIR, PREFER_PAIR or ALWAYS_INCLUDE")
+	}
+}
+
+// PairingRule — правило подбора пары автор–ревьюер внутри команды.
+// NEVER_PAIR симметрично: пользователи никогда не ревьюят друг друга.
+// PREFER_PAIR: ReviewerID выбирается в первую очередь для PR автора AuthorID.
+// ALWAYS_INCLUDE: ReviewerID всегда назначается на PR автора AuthorID, а при
+// пустом AuthorID — на любой PR команды.
+type PairingRule struct {
+	Type       PairingRuleType
+	AuthorID   string
+	ReviewerID string
+}
+
+func NewPairingRule(ruleType PairingRuleType, authorID, reviewerID string) (*PairingRule, error) {
+	if reviewerID == "" || authorID == reviewerID {
+		return nil, InvalidArgument("reviewer_id", "must be set and differ from author_id")
+	}
+	if authorID == "" && ruleType != PairingAlwaysInclude {
+		return nil, InvalidArgument("author_id", "is required for "+string(ruleType))
+	}
+
+	return &PairingRule{
+		Type:       ruleType,
+		AuthorID:   authorID,
+		ReviewerID: reviewerID,
+	}, nil
+}
+
+func (r *PairingRule) appliesTo(authorID, reviewerID string) bool {
+	if r.Type == PairingNever {
+		return (r.AuthorID == authorID && r.ReviewerID == reviewerID) ||
+			(r.AuthorID == reviewerID && r.ReviewerID == authorID)
+	}
+	return r.ReviewerID == reviewerID && (r.AuthorID == "" || r.AuthorID == authorID)
+}
+
+type PairingRules []*PairingRule
+
+// Find возвращает первое правило типа ruleType, действующее для пары.
+func (rules PairingRules) Find(ruleType PairingRuleType, authorID, reviewerID string) *PairingRule {
+	for _, rule := range rules {
+		if rule.Type == ruleType && rule.appliesTo(authorID, reviewerID) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Validate отклоняет правила, противоречащие NEVER_PAIR для той же пары.
+func (rules PairingRules) Validate() error {
+	for _, rule := range rules {
+		if rule.Type != PairingNever && rule.AuthorID != "" && rules.Find(PairingNever, rule.AuthorID, rule.ReviewerID) != nil {
+			return InvalidArgument("rules", "rule for "+rule.AuthorID+" and "+rule.ReviewerID+" contradicts NEVER_PAIR")
+		}
+	}
+	return nil
+}
+
+type PairingStatus string
+
+const (
+	PairingRequired  PairingStatus = "REQUIRED"
+	PairingPreferred PairingStatus = "PREFERRED"
+	PairingEligible  PairingStatus = "ELIGIBLE"
+	PairingExcluded  PairingStatus = "EXCLUDED"
+)
+
+// PairingDecision объясняет, как правила команды влияют на кандидата.
+// Reason — AUTHOR, INACTIVE, ABSENT или тип сработавшего правила.
+type PairingDecision struct {
+	User   *User
+	Status PairingStatus
+	Reason string
+	Rule   *PairingRule
+}
+
+// DecidePairing определяет статус кандидата для PR автора authorID.
+// NEVER_PAIR важнее ALWAYS_INCLUDE и PREFER_PAIR.
+func (rules PairingRules) DecidePairing(authorID string, user *User, at time.Time) PairingDecision {
+	decision := PairingDecision{User: user, Status: PairingEligible}
+
+	switch {
+	case user.UserID == authorID:
+		decision.Status, decision.Reason = PairingExcluded, ReasonAuthor
+	case !user.IsAvailableAt(at):
+		decision.Status, decision.Reason = PairingExcluded, unavailableReason(user)
+	default:
+		for _, step := range []struct {
+			ruleType PairingRuleType
+			status   PairingStatus
+		}{
+			{PairingNever, PairingExcluded},
+			{PairingAlwaysInclude, PairingRequired},
+			{PairingPrefer, PairingPreferred},
+		} {
+			if rule := rules.Find(step.ruleType, authorID, user.UserID); rule != nil {
+				decision.Status, decision.Reason, decision.Rule = step.status, string(rule.Type), rule
+				break
+			}
+		}
+	}
+
+	return decision
+}
+
+// ExplainPairing возвращает решение по каждому участнику команды для PR
+// автора authorID, отсортированное по ID участника.
+func (t *Team) ExplainPairing(authorID string, at time.Time) []PairingDecision {
+	decisions := make([]PairingDecision, 0, len(t.Members))
+	for _, member := range t.Members {
+		decisions = append(decisions, t.PairingRules.DecidePairing(authorID, member, at))
+	}
+
+	sort.Slice(decisions, func(i, j int) bool {
+		return decisions[i].User.UserID < decisions[j].User.UserID
+	})
+
+	return decisions
+}
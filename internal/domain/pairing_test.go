@@ -0,0 +1,110 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func TestReviewerAssigner_PairingRules(t *testing.T) {
+	clock := domain.FixedClock{Time: time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)}
+
+	members := make([]*domain.User, 0, 6)
+	for i := 1; i <= 6; i++ {
+		members = append(members, domain.NewUser(fmt.Sprintf("u%d", i), fmt.Sprintf("user%d", i), "backend", true))
+	}
+	team := domain.NewTeam("backend", members)
+	team.PairingRules = domain.PairingRules{
+		{Type: domain.PairingNever, AuthorID: "u1", ReviewerID: "u2"},
+		{Type: domain.PairingAlwaysInclude, ReviewerID: "u6"},
+		{Type: domain.PairingPrefer, AuthorID: "u3", ReviewerID: "u4"},
+	}
+
+	assigner := domain.NewReviewerAssigner(clock, domain.NewRandomSource(42), 24*time.Hour, domain.FairnessPolicy{})
+
+	// Тест проверяет соблюдение правил на длинной серии назначений.
+	// Ожидается: u6 всегда назначен, пара u1–u2 не встречается, у автора u3 всегда есть u4.
+	t.Run("AssignReviewers - honors rules", func(t *testing.T) {
+		tests := []struct {
+			author     *domain.User
+			notContain string
+			want       []string
+		}{
+			{author: members[0], notContain: "u2"},
+			{author: members[1], notContain: "u1"},
+			{author: members[2], want: []string{"u6", "u4"}},
+			{author: members[3]},
+			{author: members[4]},
+		}
+
+		for _, tt := range tests {
+			t.Run("author "+tt.author.UserID, func(t *testing.T) {
+				for i := 0; i < 40; i++ {
+					assignment, err := assigner.AssignReviewers(domain.AssignmentRequest{Team: team, Author: tt.author, MaxReviewers: 2})
+					require.NoError(t, err)
+
+					assert.Contains(t, assignment.ReviewerIDs, "u6")
+					if tt.notContain != "" {
+						assert.NotContains(t, assignment.ReviewerIDs, tt.notContain)
+					}
+					if tt.want != nil {
+						assert.Equal(t, tt.want, assignment.ReviewerIDs)
+					}
+				}
+			})
+		}
+	})
+
+	// Тест проверяет, что правило NEVER_PAIR исключает и владельцев кода.
+	// Ожидается: владелец из пары NEVER_PAIR пропускается, назначается другой владелец группы.
+	t.Run("AssignReviewers - skips never pair owner", func(t *testing.T) {
+		assignment, err := assigner.AssignReviewers(domain.AssignmentRequest{
+			Team:         team,
+			Author:       members[0],
+			MaxReviewers: 2,
+			OwnerGroups:  [][]*domain.User{{members[1], members[4]}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"u6", "u5"}, assignment.ReviewerIDs)
+	})
+
+	// Тест проверяет выбор замены с учётом правил пар автора.
+	// Ожидается: пара NEVER_PAIR не выбирается, PREFER_PAIR выбирается первой.
+	t.Run("FindReplacementCandidate - honors rules", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			authorID   string
+			excludeIDs []string
+			notWant    string
+			want       string
+		}{
+			{name: "never pair", authorID: "u1", excludeIDs: []string{"u1", "u6", "u3"}, notWant: "u2"},
+			{name: "prefer pair", authorID: "u3", excludeIDs: []string{"u3", "u6", "u1"}, want: "u4"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				for i := 0; i < 50; i++ {
+					replacement, err := assigner.FindReplacementCandidate(domain.ReplacementRequest{
+						Team:           team,
+						AuthorID:       tt.authorID,
+						ExcludeUserIDs: tt.excludeIDs,
+						PairingRules:   team.PairingRules,
+					})
+					require.NoError(t, err)
+					if tt.notWant != "" {
+						assert.NotEqual(t, tt.notWant, replacement.Reviewer.UserID)
+					}
+					if tt.want != "" {
+						assert.Equal(t, tt.want, replacement.Reviewer.UserID)
+					}
+				}
+			})
+		}
+	})
+}
@@ -9,36 +9,74 @@ const (
 	StatusMerged PRStatus = "MERGED"
 )
 
+// ReviewersPerPR — сколько ревьюеров назначается на PR, если команда не
+// задала свою цель.
+const ReviewersPerPR = 2
+
+// MaxReviewersTarget — наибольшая цель по числу ревьюеров, которую может
+// задать команда.
+const MaxReviewersTarget = 10
+
+// ParsePRStatus разбирает статус PR из фильтра или запроса.
+func ParsePRStatus(value string) (PRStatus, error) {
+	switch status := PRStatus(value); status {
+	case StatusOpen, StatusMerged:
+		return status, nil
+	}
+	return "", InvalidArgument("status", "must be OPEN or MERGED")
+}
+
+// PRFilter — условия выборки PR. Пустые поля выборку не ограничивают;
+// TeamName — команда автора, интервал создания — [CreatedFrom, CreatedTo).
+type PRFilter struct {
+	AuthorID    string
+	TeamName    string
+	ReviewerID  string
+	Status      PRStatus
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Limit       int
+	Offset      int
+}
+
 type PullRequest struct {
 	ID                string
 	Name              string
 	AuthorID          string
 	Status            PRStatus
 	AssignedReviewers []string
+	Labels            []string
 	CreatedAt         time.Time
 	MergedAt          *time.Time
+	// FallbackTeams — ревьюеры, назначенные из запасных команд, и их команды
+	FallbackTeams map[string]string
+	// StaleReviewers — неактивные ревьюеры, за которыми оставлены ревью, и
+	// время, с которого ревью считаются устаревшими
+	StaleReviewers map[string]time.Time
+	// Version растёт при каждом изменении PR, включая ревьюеров
+	Version   int64
+	UpdatedAt time.Time
 }
 
-func NewPullRequest(id, name, authorID string, reviewers []string) *PullRequest {
+func NewPullRequest(id, name, authorID string, reviewers []string, createdAt time.Time) *PullRequest {
 	return &PullRequest{
 		ID:                id,
 		Name:              name,
 		AuthorID:          authorID,
 		Status:            StatusOpen,
 		AssignedReviewers: reviewers,
-		CreatedAt:         time.Now(),
+		CreatedAt:         createdAt,
 		MergedAt:          nil,
 	}
 }
 
-func (pr *PullRequest) Merge() error {
+func (pr *PullRequest) Merge(at time.Time) error {
 	if pr.Status == StatusMerged {
 		return nil
 	}
 
 	pr.Status = StatusMerged
-	now := time.Now()
-	pr.MergedAt = &now
+	pr.MergedAt = &at
 	return nil
 }
 
@@ -50,6 +88,14 @@ func (pr *PullRequest) IsMerged() bool {
 	return pr.Status == StatusMerged
 }
 
+// MissingReviewers — сколько ревьюеров не хватает до target.
+func (pr *PullRequest) MissingReviewers(target int) int {
+	if missing := target - len(pr.AssignedReviewers); missing > 0 {
+		return missing
+	}
+	return 0
+}
+
 func (pr *PullRequest) HasReviewer(userID string) bool {
 	for _, reviewerID := range pr.AssignedReviewers {
 		if reviewerID == userID {
@@ -59,21 +105,97 @@ func (pr *PullRequest) HasReviewer(userID string) bool {
 	return false
 }
 
+func (pr *PullRequest) AddReviewer(userID string) error {
+	if !pr.CanReassign() {
+		return pr.MergedError()
+	}
+
+	if userID == pr.AuthorID {
+		return pr.ReviewerError(ErrReviewerIsAuthor, userID)
+	}
+	if pr.HasReviewer(userID) {
+		return pr.ReviewerError(ErrAlreadyAssigned, userID)
+	}
+
+	pr.AssignedReviewers = append(pr.AssignedReviewers, userID)
+	return nil
+}
+
+func (pr *PullRequest) RemoveReviewer(userID string) error {
+	if !pr.CanReassign() {
+		return pr.MergedError()
+	}
+
+	for i, reviewerID := range pr.AssignedReviewers {
+		if reviewerID == userID {
+			pr.AssignedReviewers = append(pr.AssignedReviewers[:i:i], pr.AssignedReviewers[i+1:]...)
+			delete(pr.FallbackTeams, userID)
+			return nil
+		}
+	}
+
+	return pr.ReviewerError(ErrNotAssigned, userID)
+}
+
+// CheckReviewer проверяет, можно ли вручную назначить user ревьюером PR: он
+// не автор, ещё не назначен, состоит в команде teamName, доступен в момент at
+// и не связан с автором правилом NEVER_PAIR.
+func (pr *PullRequest) CheckReviewer(user *User, teamName string, rules PairingRules, at time.Time) error {
+	switch {
+	case user.UserID == pr.AuthorID:
+		return pr.ReviewerError(ErrReviewerIsAuthor, user.UserID)
+	case pr.HasReviewer(user.UserID):
+		return pr.ReviewerError(ErrAlreadyAssigned, user.UserID)
+	case user.TeamName != teamName:
+		return pr.ReviewerError(ErrTeamMismatch, user.UserID).WithDetails(Details{"team_name": teamName})
+	case !user.IsAvailableAt(at):
+		return pr.ReviewerError(ErrReviewerInactive, user.UserID)
+	case rules.Find(PairingNever, pr.AuthorID, user.UserID) != nil:
+		return pr.ReviewerError(ErrPairingRule, user.UserID).WithDetails(Details{"author_id": pr.AuthorID})
+	}
+	return nil
+}
+
 func (pr *PullRequest) ReplaceReviewer(oldUserID, newUserID string) error {
 	if !pr.CanReassign() {
-		return ErrPRMerged
+		return pr.MergedError()
 	}
 
 	if !pr.HasReviewer(oldUserID) {
-		return ErrNotAssigned
+		return pr.ReviewerError(ErrNotAssigned, oldUserID)
+	}
+	if pr.HasReviewer(newUserID) {
+		return pr.ReviewerError(ErrAlreadyAssigned, newUserID)
 	}
 
 	for i, reviewerID := range pr.AssignedReviewers {
 		if reviewerID == oldUserID {
 			pr.AssignedReviewers[i] = newUserID
+			delete(pr.FallbackTeams, oldUserID)
 			return nil
 		}
 	}
 
-	return ErrNotAssigned
+	return pr.ReviewerError(ErrNotAssigned, oldUserID)
+}
+
+// MergedError — ErrPRMerged для этого PR.
+func (pr *PullRequest) MergedError() *DomainError {
+	return ErrPRMerged.WithDetails(Details{"pull_request_id": pr.ID})
+}
+
+// ReviewerError — ошибка назначения userID ревьюером этого PR.
+func (pr *PullRequest) ReviewerError(err *DomainError, userID string) *DomainError {
+	return err.WithDetails(Details{"pull_request_id": pr.ID, "user_id": userID})
+}
+
+// SetFallbackTeam отмечает, что ревьюер назначен из запасной команды teamName.
+func (pr *PullRequest) SetFallbackTeam(userID, teamName string) {
+	if teamName == "" {
+		return
+	}
+	if pr.FallbackTeams == nil {
+		pr.FallbackTeams = make(map[string]string)
+	}
+	pr.FallbackTeams[userID] = teamName
 }
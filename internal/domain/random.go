@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RandomSource выдаёт seed для каждого решения о назначении. Зная seed,
+// решение можно воспроизвести.
+type RandomSource interface {
+	Int63() int64
+}
+
+type lockedSource struct {
+	mu     sync.Mutex
+	source rand.Source
+}
+
+// NewRandomSource создаёт потокобезопасный источник: один и тот же seed даёт
+// одну и ту же последовательность назначений.
+func NewRandomSource(seed int64) RandomSource {
+	return &lockedSource{source: rand.NewSource(seed)}
+}
+
+// NewSystemRandomSource создаёт источник, инициализированный текущим временем.
+func NewSystemRandomSource() RandomSource {
+	return NewRandomSource(time.Now().UnixNano())
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.source.Int63()
+}
+
+// FixedSeed всегда возвращает один и тот же seed. Используется, чтобы повторить
+// решение по seed из истории назначений.
+type FixedSeed int64
+
+func (s FixedSeed) Int63() int64 {
+	return int64(s)
+}
@@ -0,0 +1,78 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func TestReviewerAssigner_Determinism(t *testing.T) {
+	clock := domain.FixedClock{Time: time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)}
+
+	members := make([]*domain.User, 0, 10)
+	for i := 1; i <= 10; i++ {
+		members = append(members, domain.NewUser(fmt.Sprintf("u%d", i), fmt.Sprintf("user%d", i), "backend", true))
+	}
+	reversed := make([]*domain.User, len(members))
+	for i, member := range members {
+		reversed[len(members)-1-i] = member
+	}
+	req := domain.AssignmentRequest{Team: domain.NewTeam("backend", members), Author: members[0], MaxReviewers: 2}
+
+	// Тест проверяет, что один и тот же seed даёт одинаковую последовательность назначений.
+	// Ожидается: два назначателя с одним seed выбирают одних и тех же ревьюеров.
+	t.Run("Same seed yields same sequence", func(t *testing.T) {
+		for _, seed := range []int64{1, 42, 1 << 40} {
+			t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+				first := domain.NewReviewerAssigner(clock, domain.NewRandomSource(seed), 24*time.Hour, domain.FairnessPolicy{})
+				second := domain.NewReviewerAssigner(clock, domain.NewRandomSource(seed), 24*time.Hour, domain.FairnessPolicy{})
+
+				for i := 0; i < 50; i++ {
+					a, err := first.AssignReviewers(req)
+					require.NoError(t, err)
+					b, err := second.AssignReviewers(req)
+					require.NoError(t, err)
+
+					assert.Equal(t, a.Seed, b.Seed)
+					assert.Equal(t, a.ReviewerIDs, b.ReviewerIDs)
+				}
+			})
+		}
+	})
+
+	// Тест проверяет, что решение воспроизводится по seed из истории назначений.
+	// Ожидается: повтор с FixedSeed даёт тех же ревьюеров независимо от порядка участников.
+	t.Run("Replay by recorded seed", func(t *testing.T) {
+		assigner := domain.NewReviewerAssigner(clock, domain.NewSystemRandomSource(), 24*time.Hour, domain.FairnessPolicy{})
+		original, err := assigner.AssignReviewers(req)
+		require.NoError(t, err)
+
+		tests := []struct {
+			name    string
+			members []*domain.User
+		}{
+			{name: "same order", members: members},
+			{name: "reversed order", members: reversed},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				replay := domain.NewReviewerAssigner(clock, domain.FixedSeed(original.Seed), 24*time.Hour, domain.FairnessPolicy{})
+				replayed, err := replay.AssignReviewers(domain.AssignmentRequest{
+					Team:         domain.NewTeam("backend", tt.members),
+					Author:       members[0],
+					MaxReviewers: 2,
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, original.ReviewerIDs, replayed.ReviewerIDs)
+				assert.Equal(t, original.Seed, replayed.Seed)
+			})
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+type CandidateStatus string
+
+const (
+	CandidateChosen    CandidateStatus = "CHOSEN"
+	CandidateNotChosen CandidateStatus = "NOT_CHOSEN"
+	CandidateExcluded  CandidateStatus = "EXCLUDED"
+)
+
+// Причины решения по кандидату. Для правил пар причиной служит тип правила.
+const (
+	ReasonAuthor          = "AUTHOR"
+	ReasonInactive        = "INACTIVE"
+	ReasonAbsent          = "ABSENT"
+	ReasonAlreadyAssigned = "ALREADY_ASSIGNED"
+	ReasonReplaced        = "REPLACED"
+	ReasonCodeOwner       = "CODE_OWNER"
+	ReasonSeniorityRule   = "SENIORITY_RULE"
+	ReasonTopRanked       = "TOP_RANKED"
+	ReasonRankedLower     = "RANKED_LOWER"
+	ReasonRequested       = "REQUESTED"
+	ReasonFallbackTeam    = "FALLBACK_TEAM"
+)
+
+// CandidateDecision объясняет, почему кандидат выбран, не выбран или исключён.
+type CandidateDecision struct {
+	UserID string
+	Status CandidateStatus
+	Reason string
+	// Rank — место кандидата в ранжировании, начиная с 1; 0 — не ранжировался
+	Rank int
+}
+
+// AssignmentReasoning — объяснение одного решения о назначении. Хранится рядом
+// с историей назначений, чтобы ответить на вопрос «почему мне достался этот PR».
+type AssignmentReasoning struct {
+	ID        int64
+	PRID      string
+	Action    AssignmentAction
+	Seed      int64
+	Decisions []CandidateDecision
+	CreatedAt time.Time
+}
+
+func NewAssignmentReasoning(prID string, action AssignmentAction, seed int64, decisions []CandidateDecision, createdAt time.Time) *AssignmentReasoning {
+	return &AssignmentReasoning{
+		PRID:      prID,
+		Action:    action,
+		Seed:      seed,
+		Decisions: decisions,
+		CreatedAt: createdAt,
+	}
+}
+
+// unavailableReason объясняет, почему пользователь недоступен для ревью.
+func unavailableReason(user *User) string {
+	if !user.IsActive {
+		return ReasonInactive
+	}
+	return ReasonAbsent
+}
+
+// decisionLog собирает решения по кандидатам в ходе одного назначения.
+// Повторное решение по тому же пользователю заменяет предыдущее.
+type decisionLog struct {
+	decisions map[string]*CandidateDecision
+}
+
+func newDecisionLog() *decisionLog {
+	return &decisionLog{decisions: make(map[string]*CandidateDecision)}
+}
+
+func (l *decisionLog) set(userID string, status CandidateStatus, reason string) {
+	decision, ok := l.decisions[userID]
+	if !ok {
+		decision = &CandidateDecision{UserID: userID}
+		l.decisions[userID] = decision
+	}
+	decision.Status = status
+	decision.Reason = reason
+}
+
+// ranked запоминает места кандидатов в ранжировании.
+func (l *decisionLog) ranked(users []*User) {
+	for i, user := range users {
+		l.set(user.UserID, CandidateNotChosen, ReasonRankedLower)
+		l.decisions[user.UserID].Rank = i + 1
+	}
+}
+
+// list возвращает решения: сначала выбранные, затем не выбранные по месту в
+// ранжировании, затем исключённые; внутри группы — по ID пользователя.
+func (l *decisionLog) list() []CandidateDecision {
+	statusOrder := map[CandidateStatus]int{CandidateChosen: 0, CandidateNotChosen: 1, CandidateExcluded: 2}
+
+	decisions := make([]CandidateDecision, 0, len(l.decisions))
+	for _, decision := range l.decisions {
+		decisions = append(decisions, *decision)
+	}
+
+	sort.Slice(decisions, func(i, j int) bool {
+		a, b := decisions[i], decisions[j]
+		if statusOrder[a.Status] != statusOrder[b.Status] {
+			return statusOrder[a.Status] < statusOrder[b.Status]
+		}
+		if a.Status == CandidateNotChosen && a.Rank != b.Rank {
+			return a.Rank < b.Rank
+		}
+		return a.UserID < b.UserID
+	})
+
+	return decisions
+}
@@ -0,0 +1,155 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func TestReviewerAssigner_Reasoning(t *testing.T) {
+	now := time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)
+	clock := domain.FixedClock{Time: now}
+
+	members := make([]*domain.User, 0, 6)
+	for i := 1; i <= 6; i++ {
+		members = append(members, domain.NewUser(fmt.Sprintf("u%d", i), fmt.Sprintf("user%d", i), "backend", true))
+	}
+	members[4].SetActive(false)
+	absence, err := domain.NewAbsence("u6", now.AddDate(0, 0, -1), now.AddDate(0, 0, 1), "vacation")
+	require.NoError(t, err)
+	members[5].Absences = []*domain.Absence{absence}
+
+	team := domain.NewTeam("backend", members)
+	team.PairingRules = domain.PairingRules{{Type: domain.PairingAlwaysInclude, ReviewerID: "u4"}}
+
+	assigner := domain.NewReviewerAssigner(clock, domain.NewRandomSource(42), 24*time.Hour, domain.FairnessPolicy{})
+
+	// Тест проверяет, что каждое решение назначения объясняет всех участников команды.
+	// Ожидается: ALWAYS_INCLUDE и TOP_RANKED у выбранных, RANKED_LOWER у оставшегося, причины исключения у остальных.
+	t.Run("AssignReviewers - explains every member", func(t *testing.T) {
+		assignment, err := assigner.AssignReviewers(domain.AssignmentRequest{Team: team, Author: members[0], MaxReviewers: 2})
+		require.NoError(t, err)
+		require.Len(t, assignment.Decisions, 6)
+
+		reasons := make(map[string]string)
+		for _, decision := range assignment.Decisions {
+			reasons[decision.UserID] = string(decision.Status) + "/" + decision.Reason
+		}
+
+		tests := []struct {
+			userID string
+			want   string
+		}{
+			{userID: "u4", want: "CHOSEN/ALWAYS_INCLUDE"},
+			{userID: "u1", want: "EXCLUDED/AUTHOR"},
+			{userID: "u5", want: "EXCLUDED/INACTIVE"},
+			{userID: "u6", want: "EXCLUDED/ABSENT"},
+			{userID: assignment.ReviewerIDs[1], want: "CHOSEN/TOP_RANKED"},
+		}
+		for _, tt := range tests {
+			assert.Equal(t, tt.want, reasons[tt.userID], tt.userID)
+		}
+
+		first, last := assignment.Decisions[0], assignment.Decisions[len(assignment.Decisions)-1]
+		assert.Equal(t, domain.CandidateChosen, first.Status)
+		assert.Equal(t, domain.CandidateExcluded, last.Status)
+
+		for _, decision := range assignment.Decisions {
+			if decision.Status == domain.CandidateNotChosen {
+				assert.Equal(t, "RANKED_LOWER", decision.Reason)
+				assert.Equal(t, 2, decision.Rank)
+			}
+		}
+	})
+
+	// Тест проверяет обоснование выбора кандидата при правиле старшинства.
+	// Ожидается: пока правило не выполнено — при замене нужного для него ревьюера или при
+	// добавлении, — кандидаты ниже требуемого уровня исключены как SENIORITY_RULE.
+	t.Run("FindReplacementCandidate - explains seniority exclusions", func(t *testing.T) {
+		users := []*domain.User{
+			domain.NewUser("s1", "senior1", "backend", true),
+			domain.NewUser("s2", "senior2", "backend", true),
+			domain.NewUser("s3", "senior3", "backend", true),
+			domain.NewUser("j1", "junior1", "backend", true),
+			domain.NewUser("j2", "junior2", "backend", true),
+		}
+		for _, user := range users[:3] {
+			user.Seniority = domain.SenioritySenior
+		}
+		for _, user := range users[3:] {
+			user.Seniority = domain.SeniorityJunior
+		}
+		rule := domain.NewSeniorityRule(1, domain.SenioritySenior)
+
+		tests := []struct {
+			name        string
+			oldReviewer *domain.User
+			reviewers   []*domain.User
+			excludeIDs  []string
+			want        string
+			wantReasons map[string]string
+		}{
+			{
+				name:        "replace senior",
+				oldReviewer: users[1],
+				reviewers:   []*domain.User{users[1]},
+				excludeIDs:  []string{"s1", "s2", "j2"},
+				want:        "s3",
+				wantReasons: map[string]string{
+					"s1": "AUTHOR",
+					"s2": "REPLACED",
+					"s3": "TOP_RANKED",
+					"j1": "SENIORITY_RULE",
+					"j2": "ALREADY_ASSIGNED",
+				},
+			},
+			{
+				name:       "add while rule unsatisfied",
+				reviewers:  []*domain.User{users[3]},
+				excludeIDs: []string{"s1", "j1", "s2"},
+				want:       "s3",
+				wantReasons: map[string]string{
+					"s1": "AUTHOR",
+					"s2": "ALREADY_ASSIGNED",
+					"s3": "TOP_RANKED",
+					"j1": "ALREADY_ASSIGNED",
+					"j2": "SENIORITY_RULE",
+				},
+			},
+			{
+				name:       "add when rule satisfied",
+				reviewers:  []*domain.User{users[1]},
+				excludeIDs: []string{"s1", "s2", "s3", "j2"},
+				want:       "j1",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				replacement, err := assigner.FindReplacementCandidate(domain.ReplacementRequest{
+					Team:           domain.NewTeam("backend", users),
+					AuthorID:       "s1",
+					OldReviewer:    tt.oldReviewer,
+					Reviewers:      tt.reviewers,
+					ExcludeUserIDs: tt.excludeIDs,
+					SeniorityRule:  rule,
+				})
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, replacement.Reviewer.UserID)
+
+				if tt.wantReasons != nil {
+					reasons := make(map[string]string)
+					for _, decision := range replacement.Decisions {
+						reasons[decision.UserID] = decision.Reason
+					}
+					assert.Equal(t, tt.wantReasons, reasons)
+				}
+			})
+		}
+	})
+}
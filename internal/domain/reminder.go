@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+type ReminderFrequency string
+
+const (
+	ReminderOff    ReminderFrequency = "OFF"
+	ReminderDaily  ReminderFrequency = "DAILY"
+	ReminderWeekly ReminderFrequency = "WEEKLY"
+)
+
+func ParseReminderFrequency(value string) (ReminderFrequency, error) {
+	switch frequency := ReminderFrequency(value); frequency {
+	case ReminderOff, ReminderDaily, ReminderWeekly:
+		return frequency, nil
+	default:
+		return "", InvalidArgument("frequency", "must be OFF, DAILY or WEEKLY")
+	}
+}
+
+func (f ReminderFrequency) period() time.Duration {
+	switch f {
+	case ReminderDaily:
+		return 24 * time.Hour
+	case ReminderWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// NotificationSettings — настройки напоминаний пользователя. Пользователи без
+// сохранённых настроек получают ежедневный дайджест.
+type NotificationSettings struct {
+	UserID     string
+	Email      string
+	Frequency  ReminderFrequency
+	LastSentAt *time.Time
+}
+
+func NewNotificationSettings(userID string) *NotificationSettings {
+	return &NotificationSettings{
+		UserID:    userID,
+		Frequency: ReminderDaily,
+	}
+}
+
+// IsDue сообщает, пора ли отправлять следующий дайджест.
+func (s *NotificationSettings) IsDue(now time.Time) bool {
+	if s.Frequency == ReminderOff {
+		return false
+	}
+	if s.LastSentAt == nil {
+		return true
+	}
+	return !now.Before(s.LastSentAt.Add(s.Frequency.period()))
+}
+
+// ReviewDigest — сводка открытых PR, ожидающих ревью пользователя.
+type ReviewDigest struct {
+	User         *User
+	Email        string
+	PullRequests []*PullRequest
+	GeneratedAt  time.Time
+}
@@ -2,67 +2,400 @@ package domain
 
 import (
 	"math/rand"
+	"sort"
 	"time"
 )
 
-type ReviewerAssigner struct{}
+type ReviewerAssigner struct {
+	clock     Clock
+	random    RandomSource
+	reviewSLA time.Duration
+	fairness  FairnessPolicy
+}
 
-func NewReviewerAssigner() *ReviewerAssigner {
-	return &ReviewerAssigner{}
+// NewReviewerAssigner создаёт назначатель. reviewSLA — окно, в течение которого
+// ревьюер должен выйти на работу, чтобы считаться доступным для нового PR.
+func NewReviewerAssigner(clock Clock, random RandomSource, reviewSLA time.Duration, fairness FairnessPolicy) *ReviewerAssigner {
+	return &ReviewerAssigner{
+		clock:     clock,
+		random:    random,
+		reviewSLA: reviewSLA,
+		fairness:  fairness,
+	}
 }
 
-func (ra *ReviewerAssigner) AssignReviewers(team *Team, author *User, maxReviewers int) []string {
-	if maxReviewers <= 0 {
-		return []string{}
+// FairnessWindow — сколько последних назначений команды нужно передать в
+// RecentReviewerIDs. 0 — ротация отключена.
+func (ra *ReviewerAssigner) FairnessWindow() int {
+	if !ra.fairness.Enabled() {
+		return 0
 	}
+	return ra.fairness.Window
+}
 
-	candidates := team.GetActiveMembersExcluding(author.UserID)
+// Assignment — результат назначения, seed, с которым перемешивались кандидаты,
+// и решения по каждому рассмотренному кандидату.
+type Assignment struct {
+	ReviewerIDs []string
+	Seed        int64
+	Decisions   []CandidateDecision
+	// FallbackTeams — ревьюеры из запасных команд и их команды
+	FallbackTeams map[string]string
+}
 
-	if len(candidates) == 0 {
-		return []string{}
+type Replacement struct {
+	Reviewer  *User
+	Seed      int64
+	Decisions []CandidateDecision
+	// FallbackTeam — запасная команда замены; пусто, если замена из основной
+	FallbackTeam string
+}
+
+type AssignmentRequest struct {
+	Team         *Team
+	Author       *User
+	MaxReviewers int
+	OwnerGroups  [][]*User
+	Labels       []string
+	// RecentReviewerIDs — последние назначения в команде, от старых к новым
+	RecentReviewerIDs []string
+	// FallbackTeams — запасные команды в порядке обращения
+	FallbackTeams []*Team
+}
+
+// AssignReviewers сначала назначает ревьюеров по правилам ALWAYS_INCLUDE, затем
+// по одному владельцу на каждую группу из OwnerGroups, добирает ревьюеров нужного
+// уровня по правилу старшинства команды и заполняет оставшиеся места до
+// MaxReviewers. Если команде не хватает кандидатов, недостающие берутся из
+// FallbackTeams. Пары NEVER_PAIR с автором не назначаются никогда. Порядок
+// кандидатов определяет rank.
+func (ra *ReviewerAssigner) AssignReviewers(req AssignmentRequest) (*Assignment, error) {
+	now := ra.clock.Now()
+	seed := ra.random.Int63()
+
+	rules := req.Team.PairingRules
+	ranking := &ranking{
+		labels:    req.Labels,
+		now:       now,
+		reviewSLA: ra.reviewSLAFor(req.Team),
+		load:      ra.fairness.newRotation(req.RecentReviewerIDs, req.Team.GetActiveMembers(now), req.MaxReviewers),
+		preferred: func(user *User) bool {
+			return rules.Find(PairingPrefer, req.Author.UserID, user.UserID) != nil
+		},
+		r: rand.New(rand.NewSource(seed)),
 	}
 
-	count := len(candidates)
-	if count > maxReviewers {
-		count = maxReviewers
+	log := newDecisionLog()
+
+	var reviewers, candidates []*User
+	for _, decision := range req.Team.ExplainPairing(req.Author.UserID, now) {
+		switch decision.Status {
+		case PairingRequired:
+			reviewers = append(reviewers, decision.User)
+			log.set(decision.User.UserID, CandidateChosen, decision.Reason)
+		case PairingPreferred, PairingEligible:
+			candidates = append(candidates, decision.User)
+		case PairingExcluded:
+			log.set(decision.User.UserID, CandidateExcluded, decision.Reason)
+		}
+	}
+
+	assigned := make(map[string]bool)
+	for _, reviewer := range reviewers {
+		assigned[reviewer.UserID] = true
+	}
+
+	for _, owner := range ra.assignOwners(req.Author, req.OwnerGroups, rules, assigned, ranking) {
+		assigned[owner.UserID] = true
+		reviewers = append(reviewers, owner)
+		log.set(owner.UserID, CandidateChosen, ReasonCodeOwner)
 	}
 
-	shuffled := ra.shuffle(candidates)
+	var remaining []*User
+	for _, candidate := range candidates {
+		if !assigned[candidate.UserID] {
+			remaining = append(remaining, candidate)
+		}
+	}
+
+	ranked := ranking.rank(remaining)
+
+	fallback := &fallbackPool{}
+	if needsFallback(req, reviewers, ranked) {
+		fallback = collectFallback(req.FallbackTeams, req.Author.UserID, rules, assigned, ranking, log)
+		ranked = append(ranked, fallback.candidates...)
+	}
+	log.ranked(ranked)
+
+	if rule := req.Team.SeniorityRule; rule != nil {
+		missing := rule.MinReviewers - rule.CountSatisfying(reviewers)
+
+		var rest []*User
+		for _, candidate := range ranked {
+			if missing > 0 && rule.IsSatisfiedBy(candidate) {
+				reviewers = append(reviewers, candidate)
+				log.set(candidate.UserID, CandidateChosen, ReasonSeniorityRule)
+				missing--
+			} else {
+				rest = append(rest, candidate)
+			}
+		}
+
+		if missing > 0 {
+			return nil, rule.Violation()
+		}
+		ranked = rest
+	}
+
+	for _, candidate := range ranked {
+		if len(reviewers) >= req.MaxReviewers {
+			break
+		}
+		reviewers = append(reviewers, candidate)
+		if fallback.teamOf[candidate.UserID] != "" {
+			log.set(candidate.UserID, CandidateChosen, ReasonFallbackTeam)
+		} else {
+			log.set(candidate.UserID, CandidateChosen, ReasonTopRanked)
+		}
+	}
+
+	reviewerIDs := make([]string, 0, len(reviewers))
+	fallbackTeams := make(map[string]string)
+	for _, reviewer := range reviewers {
+		reviewerIDs = append(reviewerIDs, reviewer.UserID)
+		if teamName := fallback.teamOf[reviewer.UserID]; teamName != "" {
+			fallbackTeams[reviewer.UserID] = teamName
+		}
+	}
+
+	return &Assignment{ReviewerIDs: reviewerIDs, Seed: seed, Decisions: log.list(), FallbackTeams: fallbackTeams}, nil
+}
+
+// assignOwners выбирает по одному владельцу на каждую группу, которую ещё не
+// покрывают уже назначенные ревьюеры.
+func (ra *ReviewerAssigner) assignOwners(author *User, ownerGroups [][]*User, rules PairingRules, assigned map[string]bool, ranking *ranking) []*User {
+	var owners []*User
+	chosen := make(map[string]bool)
+
+	for _, group := range ownerGroups {
+		var candidates []*User
+		covered := false
+		for _, owner := range group {
+			if assigned[owner.UserID] || chosen[owner.UserID] {
+				covered = true
+				break
+			}
+			if owner.IsAvailableAt(ranking.now) && owner.UserID != author.UserID &&
+				rules.Find(PairingNever, author.UserID, owner.UserID) == nil {
+				candidates = append(candidates, owner)
+			}
+		}
+
+		if covered || len(candidates) == 0 {
+			continue
+		}
 
-	reviewers := make([]string, 0, count)
-	for i := 0; i < count; i++ {
-		reviewers = append(reviewers, shuffled[i].UserID)
+		owner := ranking.rank(candidates)[0]
+		chosen[owner.UserID] = true
+		owners = append(owners, owner)
 	}
 
-	return reviewers
+	return owners
 }
 
-func (ra *ReviewerAssigner) FindReplacementCandidate(team *Team, excludeUserIDs []string) (*User, error) {
+type ReplacementRequest struct {
+	Team           *Team
+	AuthorID       string
+	OldReviewer    *User
+	Reviewers      []*User
+	ExcludeUserIDs []string
+	Labels         []string
+	SeniorityRule  *SeniorityRule
+	// PairingRules — правила команды автора PR
+	PairingRules PairingRules
+	// RecentReviewerIDs — последние назначения в команде, от старых к новым
+	RecentReviewerIDs []string
+	// FallbackTeams — запасные команды в порядке обращения
+	FallbackTeams []*Team
+}
+
+// FindReplacementCandidate выбирает замену для OldReviewer, а без него —
+// ревьюера в дополнение к Reviewers. Если уходящий ревьюер нужен для
+// выполнения правила старшинства или правило среди Reviewers ещё не
+// выполнено, кандидат тоже должен ему соответствовать. Пары NEVER_PAIR с
+// автором исключаются, а ALWAYS_INCLUDE и PREFER_PAIR выбираются в первую очередь. Если в команде замены нет, она
+// ищется в FallbackTeams по порядку.
+func (ra *ReviewerAssigner) FindReplacementCandidate(req ReplacementRequest) (*Replacement, error) {
 	excludeMap := make(map[string]bool)
-	for _, id := range excludeUserIDs {
+	for _, id := range req.ExcludeUserIDs {
 		excludeMap[id] = true
 	}
 
+	now := ra.clock.Now()
+	log := newDecisionLog()
+	requiresSenior := ra.requiresSenior(req)
+
 	var candidates []*User
-	for _, member := range team.GetActiveMembers() {
-		if !excludeMap[member.UserID] {
-			candidates = append(candidates, member)
+	var from *Team
+	seniorityBlocked := false
+	for _, team := range append([]*Team{req.Team}, req.FallbackTeams...) {
+		eligible := ra.replacementCandidates(req, team, excludeMap, now, log)
+		if len(eligible) > 0 && requiresSenior {
+			var seniors []*User
+			for _, candidate := range eligible {
+				if req.SeniorityRule.IsSatisfiedBy(candidate) {
+					seniors = append(seniors, candidate)
+				} else {
+					log.set(candidate.UserID, CandidateExcluded, ReasonSeniorityRule)
+				}
+			}
+			seniorityBlocked = seniorityBlocked || len(seniors) == 0
+			eligible = seniors
+		}
+
+		if len(eligible) > 0 {
+			candidates, from = eligible, team
+			break
 		}
 	}
 
 	if len(candidates) == 0 {
+		if seniorityBlocked {
+			return nil, req.SeniorityRule.Violation()
+		}
 		return nil, ErrNoCandidate
 	}
 
-	shuffled := ra.shuffle(candidates)
-	return shuffled[0], nil
+	seed := ra.random.Int63()
+	ranking := &ranking{
+		labels:    req.Labels,
+		now:       now,
+		reviewSLA: ra.reviewSLAFor(req.Team),
+		load:      ra.fairness.newRotation(req.RecentReviewerIDs, req.Team.GetActiveMembers(now), 1),
+		preferred: func(user *User) bool {
+			return req.PairingRules.Find(PairingAlwaysInclude, req.AuthorID, user.UserID) != nil ||
+				req.PairingRules.Find(PairingPrefer, req.AuthorID, user.UserID) != nil
+		},
+		r: rand.New(rand.NewSource(seed)),
+	}
+
+	ranked := ranking.rank(candidates)
+	log.ranked(ranked)
+
+	replacement := &Replacement{Reviewer: ranked[0], Seed: seed}
+	if from != req.Team {
+		replacement.FallbackTeam = from.TeamName
+		log.set(ranked[0].UserID, CandidateChosen, ReasonFallbackTeam)
+	} else {
+		log.set(ranked[0].UserID, CandidateChosen, ReasonTopRanked)
+	}
+	replacement.Decisions = log.list()
+
+	return replacement, nil
+}
+
+// replacementCandidates возвращает участников team, которые могут заменить
+// OldReviewer, и записывает причины исключения остальных.
+func (ra *ReviewerAssigner) replacementCandidates(req ReplacementRequest, team *Team, excludeMap map[string]bool, now time.Time, log *decisionLog) []*User {
+	var candidates []*User
+	for _, member := range team.Members {
+		switch {
+		case member.UserID == req.AuthorID:
+			log.set(member.UserID, CandidateExcluded, ReasonAuthor)
+		case req.OldReviewer != nil && member.UserID == req.OldReviewer.UserID:
+			log.set(member.UserID, CandidateExcluded, ReasonReplaced)
+		case excludeMap[member.UserID]:
+			log.set(member.UserID, CandidateExcluded, ReasonAlreadyAssigned)
+		case !member.IsAvailableAt(now):
+			log.set(member.UserID, CandidateExcluded, unavailableReason(member))
+		case req.PairingRules.Find(PairingNever, req.AuthorID, member.UserID) != nil:
+			log.set(member.UserID, CandidateExcluded, string(PairingNever))
+		default:
+			candidates = append(candidates, member)
+		}
+	}
+	return candidates
 }
 
-func (ra *ReviewerAssigner) shuffle(users []*User) []*User {
+// requiresSenior сообщает, должен ли кандидат соответствовать правилу
+// старшинства: при замене — если его нарушит уход OldReviewer, при добавлении —
+// пока среди Reviewers правило не выполнено.
+func (ra *ReviewerAssigner) requiresSenior(req ReplacementRequest) bool {
+	if req.SeniorityRule == nil {
+		return false
+	}
+	if req.OldReviewer == nil {
+		return req.SeniorityRule.CountSatisfying(req.Reviewers) < req.SeniorityRule.MinReviewers
+	}
+	return req.SeniorityRule.RequiresReplacement(req.Reviewers, req.OldReviewer)
+}
+
+// ranking — всё, что влияет на порядок кандидатов в одном решении.
+type ranking struct {
+	labels    []string
+	now       time.Time
+	reviewSLA time.Duration
+	load      *rotation
+	preferred func(user *User) bool
+	r         *rand.Rand
+}
+
+// rank перемешивает кандидатов и ставит вперёд тех, кто успеет посмотреть PR
+// в пределах SLA, среди них — предпочтительных по правилам пар, затем тех, чьё
+// назначение не нарушит ротацию, а затем — тех, у кого больше тегов совпадает с
+// метками PR. При равенстве раньше идёт тот, кого дольше не назначали, затем
+// порядок случайный.
+func (rk *ranking) rank(users []*User) []*User {
+	shuffled := shuffle(users, rk.r)
+
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		iReachable := isReachable(shuffled[i], rk.now, rk.reviewSLA)
+		jReachable := isReachable(shuffled[j], rk.now, rk.reviewSLA)
+		if iReachable != jReachable {
+			return iReachable
+		}
+		iPreferred, jPreferred := rk.preferred(shuffled[i]), rk.preferred(shuffled[j])
+		if iPreferred != jPreferred {
+			return iPreferred
+		}
+		iPriority, jPriority := rk.load.priority(shuffled[i]), rk.load.priority(shuffled[j])
+		if iPriority != jPriority {
+			return iPriority < jPriority
+		}
+		iTags, jTags := shuffled[i].CountMatchingTags(rk.labels), shuffled[j].CountMatchingTags(rk.labels)
+		if iTags != jTags {
+			return iTags > jTags
+		}
+		return rk.load.lastAssigned(shuffled[i]) < rk.load.lastAssigned(shuffled[j])
+	})
+
+	return shuffled
+}
+
+// isReachable сообщает, работает ли пользователь сейчас или выйдет на работу
+// в пределах SLA.
+func isReachable(user *User, now time.Time, reviewSLA time.Duration) bool {
+	return !user.NextWorkingTime(now).After(now.Add(reviewSLA))
+}
+
+// reviewSLAFor возвращает SLA команды, если он задан, иначе SLA по умолчанию.
+func (ra *ReviewerAssigner) reviewSLAFor(team *Team) time.Duration {
+	if team != nil && team.SLAPolicy != nil {
+		return team.SLAPolicy.ReviewSLA
+	}
+	return ra.reviewSLA
+}
+
+// shuffle перемешивает копию списка. Кандидаты предварительно сортируются по
+// ID, чтобы результат зависел только от seed, а не от порядка строк из БД.
+func shuffle(users []*User, r *rand.Rand) []*User {
 	shuffled := make([]*User, len(users))
 	copy(shuffled, users)
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sort.Slice(shuffled, func(i, j int) bool {
+		return shuffled[i].UserID < shuffled[j].UserID
+	})
+
 	r.Shuffle(len(shuffled), func(i, j int) {
 		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 	})
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkingHours — рабочее время пользователя в его часовом поясе, в минутах от
+// полуночи. Рабочими считаются дни с понедельника по пятницу.
+type WorkingHours struct {
+	Start int
+	End   int
+}
+
+func ParseWorkingHours(start, end string) (*WorkingHours, error) {
+	startMinute, err := parseClockTime("work_start", start)
+	if err != nil {
+		return nil, err
+	}
+	endMinute, err := parseClockTime("work_end", end)
+	if err != nil {
+		return nil, err
+	}
+
+	if endMinute <= startMinute {
+		return nil, InvalidArgument("work_end", "must be after work_start")
+	}
+
+	return &WorkingHours{Start: startMinute, End: endMinute}, nil
+}
+
+func parseClockTime(field, value string) (int, error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, InvalidArgument(field, "must be HH:MM").Wrap(err)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+func FormatClockTime(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func ValidateTimeZone(timeZone string) error {
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return InvalidArgument("time_zone", "unknown time zone").Wrap(err)
+	}
+	return nil
+}
+
+func (h *WorkingHours) contains(local time.Time) bool {
+	if local.Weekday() == time.Saturday || local.Weekday() == time.Sunday {
+		return false
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+	return minute >= h.Start && minute < h.End
+}
+
+// nextStart возвращает ближайшее начало рабочего дня не раньше local.
+func (h *WorkingHours) nextStart(local time.Time) time.Time {
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+	for i := 0; i < 8; i++ {
+		start := day.AddDate(0, 0, i).Add(time.Duration(h.Start) * time.Minute)
+		if start.Weekday() == time.Saturday || start.Weekday() == time.Sunday {
+			continue
+		}
+		if !start.Before(local) {
+			return start
+		}
+	}
+	return local
+}
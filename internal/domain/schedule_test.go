@@ -0,0 +1,100 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func TestReviewerAssigner_WorkingHours(t *testing.T) {
+	hours, err := domain.ParseWorkingHours("09:00", "18:00")
+	require.NoError(t, err)
+
+	newMember := func(userID, timeZone string) *domain.User {
+		user := domain.NewUser(userID, userID, "backend", true)
+		user.TimeZone = timeZone
+		user.WorkingHours = hours
+		return user
+	}
+
+	// Среда, 20:00 по Москве
+	wednesdayEvening := time.Date(2025, time.November, 12, 17, 0, 0, 0, time.UTC)
+
+	// Тест проверяет расчёт ближайшего рабочего времени с учётом часового пояса и выходных.
+	// Ожидается: в среду вечером — утро четверга, в пятницу вечером — утро понедельника,
+	// пользователь без рабочих часов доступен всегда.
+	t.Run("NextWorkingTime", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			user        *domain.User
+			at          time.Time
+			wantWorking bool
+			wantNext    time.Time
+		}{
+			{
+				name:     "wednesday evening",
+				user:     newMember("u1", "Europe/Moscow"),
+				at:       wednesdayEvening,
+				wantNext: time.Date(2025, time.November, 13, 6, 0, 0, 0, time.UTC),
+			},
+			{
+				name:     "friday evening",
+				user:     newMember("u1", "Europe/Moscow"),
+				at:       wednesdayEvening.AddDate(0, 0, 2),
+				wantNext: time.Date(2025, time.November, 17, 6, 0, 0, 0, time.UTC),
+			},
+			{
+				name:        "no working hours",
+				user:        domain.NewUser("u2", "u2", "backend", true),
+				at:          wednesdayEvening.AddDate(0, 0, 2),
+				wantWorking: true,
+				wantNext:    wednesdayEvening.AddDate(0, 0, 2),
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.wantWorking, tt.user.IsWorkingAt(tt.at))
+				assert.True(t, tt.user.NextWorkingTime(tt.at).Equal(tt.wantNext))
+			})
+		}
+	})
+
+	// Тест проверяет, что ревьюер, выходящий на работу в пределах SLA, считается доступным.
+	// Ожидается: при SLA 24 часа москвич конкурирует на равных, при SLA 1 час — нет.
+	t.Run("AssignReviewers - respects SLA window", func(t *testing.T) {
+		author := newMember("u1", "Europe/London")
+		team := domain.NewTeam("backend", []*domain.User{
+			author,
+			newMember("u2", "Europe/Moscow"),
+			newMember("u3", "Europe/London"),
+		})
+		req := domain.AssignmentRequest{Team: team, Author: author, MaxReviewers: 1}
+
+		tests := []struct {
+			name     string
+			sla      time.Duration
+			wantSeen map[string]bool
+		}{
+			{name: "strict", sla: time.Hour, wantSeen: map[string]bool{"u3": true}},
+			{name: "relaxed", sla: 24 * time.Hour, wantSeen: map[string]bool{"u2": true, "u3": true}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assigner := domain.NewReviewerAssigner(domain.FixedClock{Time: wednesdayEvening}, domain.NewRandomSource(1), tt.sla, domain.FairnessPolicy{})
+				seen := make(map[string]bool)
+				for i := 0; i < 100; i++ {
+					assignment, err := assigner.AssignReviewers(req)
+					require.NoError(t, err)
+					seen[assignment.ReviewerIDs[0]] = true
+				}
+				assert.Equal(t, tt.wantSeen, seen)
+			})
+		}
+	})
+}
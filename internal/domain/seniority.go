@@ -0,0 +1,80 @@
+package domain
+
+type Seniority string
+
+const (
+	SeniorityJunior Seniority = "junior"
+	SeniorityMiddle Seniority = "middle"
+	SenioritySenior Seniority = "senior"
+	SeniorityLead   Seniority = "lead"
+)
+
+var seniorityRanks = map[Seniority]int{
+	SeniorityJunior: 1,
+	SeniorityMiddle: 2,
+	SenioritySenior: 3,
+	SeniorityLead:   4,
+}
+
+func ParseSeniority(value string) (Seniority, error) {
+	seniority := Seniority(value)
+	if _, ok := seniorityRanks[seniority]; !ok {
+		return "", InvalidArgument("seniority", "must be junior, middle, senior or lead")
+	}
+	return seniority, nil
+}
+
+func (s Seniority) AtLeast(level Seniority) bool {
+	return seniorityRanks[s] >= seniorityRanks[level]
+}
+
+// SeniorityRule требует, чтобы среди ревьюеров PR было не меньше MinReviewers
+// человек с уровнем MinLevel или выше.
+type SeniorityRule struct {
+	MinReviewers int
+	MinLevel     Seniority
+}
+
+func NewSeniorityRule(minReviewers int, minLevel Seniority) *SeniorityRule {
+	return &SeniorityRule{
+		MinReviewers: minReviewers,
+		MinLevel:     minLevel,
+	}
+}
+
+// Violation — ErrSeniorityRule с требованием правила в подробностях.
+func (r *SeniorityRule) Violation() *DomainError {
+	return ErrSeniorityRule.WithDetails(Details{"min_reviewers": r.MinReviewers, "min_level": r.MinLevel})
+}
+
+func (r *SeniorityRule) IsSatisfiedBy(user *User) bool {
+	return user.Seniority.AtLeast(r.MinLevel)
+}
+
+func (r *SeniorityRule) CountSatisfying(users []*User) int {
+	count := 0
+	for _, user := range users {
+		if r.IsSatisfiedBy(user) {
+			count++
+		}
+	}
+	return count
+}
+
+// RequiresReplacement сообщает, нарушится ли правило, если убрать removed из
+// reviewers без замены. Если правило не выполнялось и с ним, уход не считается
+// нарушением.
+func (r *SeniorityRule) RequiresReplacement(reviewers []*User, removed *User) bool {
+	if !r.IsSatisfiedBy(removed) {
+		return false
+	}
+
+	var remaining []*User
+	for _, reviewer := range reviewers {
+		if reviewer.UserID != removed.UserID {
+			remaining = append(remaining, reviewer)
+		}
+	}
+
+	return r.CountSatisfying(remaining) < r.MinReviewers
+}
@@ -0,0 +1,72 @@
+package domain
+
+import "time"
+
+type EscalationAction string
+
+const (
+	EscalationAddReviewer     EscalationAction = "ADD_REVIEWER"
+	EscalationReplaceReviewer EscalationAction = "REPLACE_REVIEWER"
+	EscalationNotify          EscalationAction = "NOTIFY"
+)
+
+func ParseEscalationAction(value string) (EscalationAction, error) {
+	switch action := EscalationAction(value); action {
+	case EscalationAddReviewer, EscalationReplaceReviewer, EscalationNotify:
+		return action, nil
+	default:
+		return "", InvalidArgument("action", "must be ADD_REVIEWER, REPLACE_REVIEWER or NOTIFY")
+	}
+}
+
+// SLAPolicy задаёт срок первого ревью для PR авторов команды и действие,
+// которое выполняется, если назначенный ревьюер не уложился в срок.
+type SLAPolicy struct {
+	ReviewSLA time.Duration
+	Action    EscalationAction
+}
+
+func NewSLAPolicy(reviewSLA time.Duration, action EscalationAction) (*SLAPolicy, error) {
+	if reviewSLA <= 0 {
+		return nil, InvalidArgument("review_sla", "must be positive")
+	}
+	return &SLAPolicy{ReviewSLA: reviewSLA, Action: action}, nil
+}
+
+func (p *SLAPolicy) IsBreached(assignedAt, now time.Time) bool {
+	return now.Sub(assignedAt) > p.ReviewSLA
+}
+
+// ReviewAssignment — ревьюер, назначенный на открытый PR.
+type ReviewAssignment struct {
+	PRID       string
+	AuthorID   string
+	AuthorTeam string
+	ReviewerID string
+	AssignedAt time.Time
+}
+
+// MaxEscalationReviewers — сколько ревьюеров эскалации ADD_REVIEWER добавляют
+// на один PR. Дальше просроченные ревью этого PR отмечаются событием NOTIFY.
+const MaxEscalationReviewers = 1
+
+// Escalation — запись о выполненном действии по нарушению SLA. Для
+// ADD_REVIEWER и REPLACE_REVIEWER NewReviewerID содержит назначенного ревьюера.
+type Escalation struct {
+	ID            int64
+	PRID          string
+	ReviewerID    string
+	Action        EscalationAction
+	NewReviewerID string
+	Details       string
+	CreatedAt     time.Time
+}
+
+func NewEscalation(prID, reviewerID string, action EscalationAction, createdAt time.Time) *Escalation {
+	return &Escalation{
+		PRID:       prID,
+		ReviewerID: reviewerID,
+		Action:     action,
+		CreatedAt:  createdAt,
+	}
+}
@@ -1,8 +1,22 @@
 package domain
 
+import "time"
+
 type Team struct {
-	TeamName string
-	Members  []*User
+	TeamName      string
+	Members       []*User
+	SeniorityRule *SeniorityRule
+	SLAPolicy     *SLAPolicy
+	PairingRules  PairingRules
+	// FallbackTeams — запасные команды в порядке обращения, когда в команде
+	// не хватает кандидатов в ревьюеры
+	FallbackTeams []string
+	// ReviewersTarget — сколько ревьюеров нужно PR авторов команды; 0 —
+	// ReviewersPerPR
+	ReviewersTarget int
+	// Version растёт при изменении команды, её правил или участников
+	Version   int64
+	UpdatedAt time.Time
 }
 
 func NewTeam(teamName string, members []*User) *Team {
@@ -12,10 +26,21 @@ func NewTeam(teamName string, members []*User) *Team {
 	}
 }
 
-func (t *Team) GetActiveMembers() []*User {
+// TargetReviewers — сколько ревьюеров назначается на PR авторов команды и до
+// скольких их добирает добор.
+func (t *Team) TargetReviewers() int {
+	if t.ReviewersTarget > 0 {
+		return t.ReviewersTarget
+	}
+	return ReviewersPerPR
+}
+
+// GetActiveMembers возвращает участников, доступных для ревью в момент at:
+// активных и не находящихся в отпуске или на больничном.
+func (t *Team) GetActiveMembers(at time.Time) []*User {
 	var active []*User
 	for _, member := range t.Members {
-		if member.IsActive {
+		if member.IsAvailableAt(at) {
 			active = append(active, member)
 		}
 	}
@@ -23,10 +48,10 @@ func (t *Team) GetActiveMembers() []*User {
 	return active
 }
 
-func (t *Team) GetActiveMembersExcluding(excludeUserID string) []*User {
+func (t *Team) GetActiveMembersExcluding(excludeUserID string, at time.Time) []*User {
 	var active []*User
 	for _, member := range t.Members {
-		if member.IsActive && member.UserID != excludeUserID {
+		if member.IsAvailableAt(at) && member.UserID != excludeUserID {
 			active = append(active, member)
 		}
 	}
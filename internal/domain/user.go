@@ -1,21 +1,116 @@
 package domain
 
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
 type User struct {
-	UserID   string
-	Username string
-	TeamName string
-	IsActive bool
+	UserID    string
+	Username  string
+	TeamName  string
+	IsActive  bool
+	Seniority Seniority
+	Tags      []string
+	Absences  []*Absence
+
+	TimeZone     string
+	WorkingHours *WorkingHours
+
+	// Version растёт при каждом изменении пользователя, включая теги
+	Version   int64
+	UpdatedAt time.Time
 }
 
 func NewUser(userID, username, teamName string, isActive bool) *User {
 	return &User{
-		UserID:   userID,
-		Username: username,
-		TeamName: teamName,
-		IsActive: isActive,
+		UserID:    userID,
+		Username:  username,
+		TeamName:  teamName,
+		IsActive:  isActive,
+		Seniority: SeniorityMiddle,
+		TimeZone:  "UTC",
 	}
 }
 
 func (u *User) SetActive(isActive bool) {
 	u.IsActive = isActive
 }
+
+// IsAvailableAt сообщает, может ли пользователь ревьюить в момент at:
+// он активен и не находится в периоде отсутствия.
+func (u *User) IsAvailableAt(at time.Time) bool {
+	if !u.IsActive {
+		return false
+	}
+
+	for _, absence := range u.Absences {
+		if absence.Covers(at) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (u *User) Location() *time.Location {
+	location, err := time.LoadLocation(u.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// IsWorkingAt сообщает, находится ли пользователь в рабочем времени в момент at.
+// Пользователи без заданного рабочего времени считаются всегда доступными.
+func (u *User) IsWorkingAt(at time.Time) bool {
+	if u.WorkingHours == nil {
+		return true
+	}
+	return u.WorkingHours.contains(at.In(u.Location()))
+}
+
+// NextWorkingTime возвращает ближайший момент не раньше at, когда пользователь
+// будет в рабочем времени.
+func (u *User) NextWorkingTime(at time.Time) time.Time {
+	if u.IsWorkingAt(at) {
+		return at
+	}
+	return u.WorkingHours.nextStart(at.In(u.Location()))
+}
+
+func (u *User) SetTags(tags []string) {
+	u.Tags = NormalizeTags(tags)
+}
+
+// CountMatchingTags возвращает количество тегов экспертизы пользователя,
+// совпадающих с метками PR.
+func (u *User) CountMatchingTags(labels []string) int {
+	count := 0
+	for _, tag := range u.Tags {
+		for _, label := range labels {
+			if tag == label {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// NormalizeTags приводит теги к нижнему регистру, убирает пустые и дубликаты.
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool)
+	normalized := []string{}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
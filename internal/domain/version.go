@@ -0,0 +1,10 @@
+package domain
+
+// CheckVersion сверяет текущую версию сущности с той, которую клиент видел
+// последней. nil означает запись без условия.
+func CheckVersion(current int64, expected *int64) error {
+	if expected != nil && *expected != current {
+		return ErrPreconditionFailed.WithDetails(Details{"current_version": current, "expected_version": *expected})
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+func digestSubject(digest *domain.ReviewDigest) string {
+	return fmt.Sprintf("%d pull request(s) waiting for your review", len(digest.PullRequests))
+}
+
+func digestText(digest *domain.ReviewDigest) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Hi %s, these pull requests are waiting for your review:\n", digest.User.Username)
+	for _, pr := range digest.PullRequests {
+		fmt.Fprintf(&b, "- %s: %s (opened %s)\n", pr.ID, pr.Name, pr.CreatedAt.Format("2006-01-02"))
+	}
+	return b.String()
+}
@@ -0,0 +1,20 @@
+package notification
+
+import (
+	"context"
+	"log"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+// LogNotifier пишет дайджест в лог. Используется, когда доставка не настроена.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Send(ctx context.Context, digest *domain.ReviewDigest) error {
+	log.Printf("Review digest for %s: %d open PR(s)", digest.User.UserID, len(digest.PullRequests))
+	return nil
+}
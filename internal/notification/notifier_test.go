@@ -0,0 +1,114 @@
+package notification_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/notification"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestNotifiers(t *testing.T) {
+	digest := &domain.ReviewDigest{
+		User:  domain.NewUser("u2", "Bob", "backend", true),
+		Email: "bob@example.com",
+		PullRequests: []*domain.PullRequest{
+			domain.NewPullRequest("pr-1", "Add search", "u1", []string{"u2"}, time.Date(2025, time.November, 12, 0, 0, 0, 0, time.UTC)),
+		},
+		GeneratedAt: time.Date(2025, time.November, 13, 9, 0, 0, 0, time.UTC),
+	}
+
+	// Тест проверяет доставку письма через SMTP без базы данных.
+	// Ожидается: фейковый сервер получает письмо с темой и списком PR; без адреса — ошибка ErrNoRecipient.
+	t.Run("SMTP", func(t *testing.T) {
+		noEmail := *digest
+		noEmail.Email = ""
+
+		tests := []struct {
+			name         string
+			digest       *domain.ReviewDigest
+			wantErr      error
+			wantMessages int
+		}{
+			{name: "delivers message", digest: digest, wantMessages: 1},
+			{name: "no recipient", digest: &noEmail, wantErr: notification.ErrNoRecipient},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				server, err := helpers.StartFakeSMTPServer()
+				require.NoError(t, err)
+				defer server.Close()
+
+				notifier := notification.NewSMTPNotifier(notification.SMTPConfig{
+					Host: server.Host(),
+					Port: server.Port(),
+					From: "pr-reviewer@example.com",
+				})
+				err = notifier.Send(context.Background(), tt.digest)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				} else {
+					require.NoError(t, err)
+				}
+
+				messages := server.Messages()
+				require.Len(t, messages, tt.wantMessages)
+				if tt.wantMessages == 0 {
+					return
+				}
+				assert.Equal(t, "pr-reviewer@example.com", messages[0].From)
+				assert.Equal(t, []string{"bob@example.com"}, messages[0].To)
+				assert.Contains(t, messages[0].Data, "Subject: 1 pull request(s) waiting for your review")
+				assert.Contains(t, messages[0].Data, "- pr-1: Add search (opened 2025-11-12)")
+			})
+		}
+	})
+
+	// Тест проверяет отправку дайджеста в чат-вебхук.
+	// Ожидается: POST с JSON, содержащим текст и список PR; ошибка при ответе не 2xx.
+	t.Run("Webhook", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			status  int
+			wantErr bool
+		}{
+			{name: "posts JSON", status: http.StatusOK},
+			{name: "accepted", status: http.StatusNoContent},
+			{name: "server error", status: http.StatusInternalServerError, wantErr: true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var payload map[string]interface{}
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodPost, r.Method)
+					assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+					body, _ := io.ReadAll(r.Body)
+					json.Unmarshal(body, &payload)
+					w.WriteHeader(tt.status)
+				}))
+				defer server.Close()
+
+				err := notification.NewWebhookNotifier(server.URL).Send(context.Background(), digest)
+				if tt.wantErr {
+					assert.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, "u2", payload["user_id"])
+				assert.Equal(t, []interface{}{"pr-1"}, payload["pull_requests"])
+				assert.Contains(t, payload["text"], "pr-1: Add search")
+			})
+		}
+	})
+}
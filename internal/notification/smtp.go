@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+var ErrNoRecipient = errors.New("recipient has no email address")
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPNotifier отправляет дайджест письмом на адрес из настроек пользователя.
+// STARTTLS используется, если сервер его поддерживает.
+type SMTPNotifier struct {
+	cfg SMTPConfig
+}
+
+func NewSMTPNotifier(cfg SMTPConfig) *SMTPNotifier {
+	return &SMTPNotifier{cfg: cfg}
+}
+
+func (n *SMTPNotifier) Send(ctx context.Context, digest *domain.ReviewDigest) error {
+	if digest.Email == "" {
+		return ErrNoRecipient
+	}
+
+	addr := net.JoinHostPort(n.cfg.Host, fmt.Sprint(n.cfg.Port))
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, n.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.cfg.Host}); err != nil {
+			return err
+		}
+	}
+
+	if n.cfg.Username != "" {
+		auth := smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.cfg.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(digest.Email); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(n.buildMessage(digest)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (n *SMTPNotifier) buildMessage(digest *domain.ReviewDigest) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", digest.Email)
+	fmt.Fprintf(&b, "Subject: %s\r\n", digestSubject(digest))
+	fmt.Fprintf(&b, "Date: %s\r\n", digest.GeneratedAt.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(digestText(digest), "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,68 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+// WebhookNotifier отправляет дайджест POST-запросом с JSON в чат-вебхук
+// (Slack, Mattermost и совместимые принимают поле text).
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type webhookPayload struct {
+	Text         string   `json:"text"`
+	UserID       string   `json:"user_id"`
+	Username     string   `json:"username"`
+	PullRequests []string `json:"pull_requests"`
+}
+
+func (n *WebhookNotifier) Send(ctx context.Context, digest *domain.ReviewDigest) error {
+	prIDs := make([]string, 0, len(digest.PullRequests))
+	for _, pr := range digest.PullRequests {
+		prIDs = append(prIDs, pr.ID)
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		Text:         digestText(digest),
+		UserID:       digest.User.UserID,
+		Username:     digest.User.Username,
+		PullRequests: prIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
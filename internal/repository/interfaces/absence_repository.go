@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+type AbsenceRepository interface {
+	Create(absence *domain.Absence) error
+
+	Delete(absenceID int64) error
+
+	GetByID(absenceID int64) (*domain.Absence, error)
+
+	GetByUserID(userID string) ([]*domain.Absence, error)
+
+	// GetUnprocessedCovering возвращает периоды, включающие date, ревью по
+	// которым ещё не переназначены.
+	GetUnprocessedCovering(date time.Time) ([]*domain.Absence, error)
+
+	MarkProcessed(absenceID int64, at time.Time) error
+}
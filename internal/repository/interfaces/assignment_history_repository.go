@@ -0,0 +1,19 @@
+package interfaces
+
+import "github.com/avito-tech-backend-autumn-2025/internal/domain"
+
+type AssignmentHistoryRepository interface {
+	Create(records []*domain.AssignmentRecord) error
+
+	GetByPRID(prID string) ([]*domain.AssignmentRecord, error)
+
+	// GetRecentReviewerIDs возвращает ревьюеров limit последних назначений
+	// участников команды, от старых к новым
+	GetRecentReviewerIDs(teamName string, limit int) ([]string, error)
+
+	// CreateReasoning сохраняет объяснение решения о назначении вместе со
+	// всеми решениями по кандидатам
+	CreateReasoning(reasoning *domain.AssignmentReasoning) error
+
+	GetReasoningByPRID(prID string) ([]*domain.AssignmentReasoning, error)
+}
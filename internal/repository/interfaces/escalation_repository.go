@@ -0,0 +1,11 @@
+package interfaces
+
+import "github.com/avito-tech-backend-autumn-2025/internal/domain"
+
+type EscalationRepository interface {
+	// Create возвращает ErrConcurrentUpdate, если эскалация той же пары PR и
+	// ревьюера уже записана
+	Create(escalation *domain.Escalation) error
+
+	GetByPRID(prID string) ([]*domain.Escalation, error)
+}
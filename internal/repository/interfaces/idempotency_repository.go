@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+type IdempotencyRepository interface {
+	// Acquire сохраняет запись, если ключа нет или он истёк к record.CreatedAt.
+	// Возвращает false, если ключ занят действующей записью.
+	Acquire(record *domain.IdempotencyRecord) (bool, error)
+
+	GetByKey(key string) (*domain.IdempotencyRecord, error)
+
+	Complete(record *domain.IdempotencyRecord) error
+
+	Delete(key string) error
+
+	DeleteExpired(now time.Time) (int, error)
+}
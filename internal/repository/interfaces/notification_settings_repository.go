@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
+
+type NotificationSettingsRepository interface {
+	Save(settings *domain.NotificationSettings) error
+
+	GetByUserID(userID string) (*domain.NotificationSettings, error)
+
+	MarkSent(userID string, sentAt time.Time) error
+}
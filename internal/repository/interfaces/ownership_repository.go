@@ -0,0 +1,9 @@
+package interfaces
+
+import "github.com/avito-tech-backend-autumn-2025/internal/domain"
+
+type OwnershipRepository interface {
+	ReplaceAll(rules []*domain.OwnershipRule) error
+
+	GetAll() ([]*domain.OwnershipRule, error)
+}
@@ -1,15 +1,38 @@
 package interfaces
 
-import "github.com/avito-tech-backend-autumn-2025/internal/domain"
+import (
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+)
 
 type PRRepository interface {
+	// Create сохраняет PR; ревьюеры считаются назначенными в pr.CreatedAt
 	Create(pr *domain.PullRequest) error
 
-	Update(pr *domain.PullRequest) error
+	// Update сохраняет PR, если его версия не менялась с момента чтения, иначе
+	// возвращает ErrConcurrentUpdate. Новые ревьюеры считаются назначенными в at:
+	// от этого времени отсчитывается SLA
+	Update(pr *domain.PullRequest, at time.Time) error
 
 	GetByID(prID string) (*domain.PullRequest, error)
 
+	GetByIDs(prIDs []string) ([]*domain.PullRequest, error)
 	GetByReviewerID(reviewerID string) ([]*domain.PullRequest, error)
+	// GetByReviewerIDs возвращает PR нескольких ревьюеров одним обращением
+	GetByReviewerIDs(reviewerIDs []string) (map[string][]*domain.PullRequest, error)
+
+	// GetOpen возвращает открытые PR; с непустым teamName — только PR авторов
+	// этой команды
+	GetOpen(teamName string) ([]*domain.PullRequest, error)
+	// List возвращает страницу PR под фильтром и общее число PR под ним
+	List(filter domain.PRFilter) ([]*domain.PullRequest, int, error)
+
+	GetOpenAssignments() ([]*domain.ReviewAssignment, error)
+
+	MarkReviewsStale(reviewerID string, at time.Time) ([]string, error)
+
+	ClearStaleReviews(reviewerID string) ([]string, error)
 
 	Exists(prID string) (bool, error)
 }
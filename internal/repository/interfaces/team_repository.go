@@ -6,6 +6,23 @@ type TeamRepository interface {
 	Create(team *domain.Team) error
 
 	GetByName(teamName string) (*domain.Team, error)
+	GetAll() ([]*domain.Team, error)
+
+	// Set-методы меняют команду, только если её версия не менялась с момента
+	// чтения team, иначе возвращают ErrConcurrentUpdate
+
+	SetSeniorityRule(team *domain.Team, rule *domain.SeniorityRule) error
+
+	SetSLAPolicy(team *domain.Team, policy *domain.SLAPolicy) error
+
+	// SetReviewersTarget задаёт цель по числу ревьюеров; 0 — по умолчанию
+	SetReviewersTarget(team *domain.Team, target int) error
+
+	// SetPairingRules полностью заменяет правила пар команды
+	SetPairingRules(team *domain.Team, rules domain.PairingRules) error
+
+	// SetFallbackTeams полностью заменяет запасные команды, сохраняя порядок
+	SetFallbackTeams(team *domain.Team, fallbackTeams []string) error
 
 	Exists(teamName string) (bool, error)
 }
@@ -0,0 +1,18 @@
+package interfaces
+
+// Repositories — репозитории, работающие внутри одной транзакции. Tx открывает
+// в ней вложенную транзакцию (точку сохранения).
+type Repositories struct {
+	Teams       TeamRepository
+	Users       UserRepository
+	PRs         PRRepository
+	History     AssignmentHistoryRepository
+	Escalations EscalationRepository
+	Tx          Transactor
+}
+
+type Transactor interface {
+	// WithinTx выполняет fn в транзакции и фиксирует её, если fn вернула nil.
+	// Ошибка fn откатывает всё, что сделано через переданные репозитории.
+	WithinTx(fn func(repos Repositories) error) error
+}
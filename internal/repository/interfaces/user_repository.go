@@ -5,11 +5,16 @@ import "github.com/avito-tech-backend-autumn-2025/internal/domain"
 type UserRepository interface {
 	Create(user *domain.User) error
 
+	// Update сохраняет пользователя, если его версия не менялась с момента
+	// чтения, иначе возвращает ErrConcurrentUpdate
 	Update(user *domain.User) error
 
 	GetByID(userID string) (*domain.User, error)
 
+	GetByIDs(userIDs []string) ([]*domain.User, error)
 	GetByTeamName(teamName string) ([]*domain.User, error)
 
+	SetTags(user *domain.User) error
+
 	Exists(userID string) (bool, error)
 }
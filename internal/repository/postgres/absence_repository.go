@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type absenceRepository struct {
+	db *sql.DB
+}
+
+func NewAbsenceRepository(db *sql.DB) interfaces.AbsenceRepository {
+	return &absenceRepository{db: db}
+}
+
+func (r *absenceRepository) Create(absence *domain.Absence) error {
+	query := `INSERT INTO user_absences (user_id, start_date, end_date, reason, created_at) 
+	          VALUES ($1, $2, $3, $4, NOW()) 
+	          RETURNING absence_id`
+
+	return r.db.QueryRow(query, absence.UserID, absence.StartDate, absence.EndDate, absence.Reason).Scan(&absence.ID)
+}
+
+func (r *absenceRepository) Delete(absenceID int64) error {
+	_, err := r.db.Exec(`DELETE FROM user_absences WHERE absence_id = $1`, absenceID)
+	return err
+}
+
+func (r *absenceRepository) GetByID(absenceID int64) (*domain.Absence, error) {
+	var absence domain.Absence
+	query := `SELECT absence_id, user_id, start_date, end_date, reason 
+	          FROM user_absences 
+	          WHERE absence_id = $1`
+
+	err := r.db.QueryRow(query, absenceID).Scan(
+		&absence.ID, &absence.UserID, &absence.StartDate, &absence.EndDate, &absence.Reason,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &absence, nil
+}
+
+func (r *absenceRepository) GetByUserID(userID string) ([]*domain.Absence, error) {
+	query := `SELECT absence_id, user_id, start_date, end_date, reason 
+	          FROM user_absences 
+	          WHERE user_id = $1 
+	          ORDER BY start_date`
+
+	return queryAbsences(r.db, query, userID)
+}
+
+func (r *absenceRepository) GetUnprocessedCovering(date time.Time) ([]*domain.Absence, error) {
+	query := `SELECT absence_id, user_id, start_date, end_date, reason 
+	          FROM user_absences 
+	          WHERE start_date <= $1 AND end_date >= $1 AND processed_at IS NULL 
+	          ORDER BY start_date, user_id`
+
+	return queryAbsences(r.db, query, domain.DateOf(date))
+}
+
+func (r *absenceRepository) MarkProcessed(absenceID int64, at time.Time) error {
+	_, err := r.db.Exec(`UPDATE user_absences SET processed_at = $2 WHERE absence_id = $1`, absenceID, at.UTC())
+	return err
+}
+
+func queryAbsences(db querier, query string, args ...interface{}) ([]*domain.Absence, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var absences []*domain.Absence
+	for rows.Next() {
+		var absence domain.Absence
+		if err := rows.Scan(
+			&absence.ID, &absence.UserID, &absence.StartDate, &absence.EndDate, &absence.Reason,
+		); err != nil {
+			return nil, err
+		}
+		absences = append(absences, &absence)
+	}
+
+	return absences, rows.Err()
+}
+
+// attachAbsences подгружает пользователям текущие и будущие периоды отсутствия,
+// которые нужны для проверки доступности при назначении.
+func attachAbsences(db querier, users []*domain.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	userIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.UserID)
+	}
+
+	query := `SELECT absence_id, user_id, start_date, end_date, reason 
+	          FROM user_absences 
+	          WHERE user_id = ANY($1) AND end_date >= CURRENT_DATE 
+	          ORDER BY start_date`
+
+	absences, err := queryAbsences(db, query, pq.Array(userIDs))
+	if err != nil {
+		return err
+	}
+
+	byUserID := make(map[string][]*domain.Absence)
+	for _, absence := range absences {
+		byUserID[absence.UserID] = append(byUserID[absence.UserID], absence)
+	}
+
+	for _, user := range users {
+		user.Absences = byUserID[user.UserID]
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type assignmentHistoryRepository struct {
+	db conn
+}
+
+func NewAssignmentHistoryRepository(db *sql.DB) interfaces.AssignmentHistoryRepository {
+	return &assignmentHistoryRepository{db: dbConn{db}}
+}
+
+func (r *assignmentHistoryRepository) Create(records []*domain.AssignmentRecord) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, record := range records {
+		query := `INSERT INTO assignment_history (pull_request_id, action, reviewer_id, replaced_reviewer_id, seed, created_at) 
+		          VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6) 
+		          RETURNING history_id`
+
+		err := tx.QueryRow(query, record.PRID, string(record.Action), record.ReviewerID,
+			record.ReplacedReviewerID, record.Seed, record.CreatedAt.UTC()).Scan(&record.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *assignmentHistoryRepository) GetByPRID(prID string) ([]*domain.AssignmentRecord, error) {
+	query := `SELECT history_id, pull_request_id, action, reviewer_id, COALESCE(replaced_reviewer_id, ''), seed, created_at 
+	          FROM assignment_history 
+	          WHERE pull_request_id = $1 
+	          ORDER BY history_id`
+
+	rows, err := r.db.Query(query, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []*domain.AssignmentRecord
+	for rows.Next() {
+		var record domain.AssignmentRecord
+		err := rows.Scan(&record.ID, &record.PRID, &record.Action, &record.ReviewerID,
+			&record.ReplacedReviewerID, &record.Seed, &record.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, &record)
+	}
+
+	return records, rows.Err()
+}
+
+func (r *assignmentHistoryRepository) GetRecentReviewerIDs(teamName string, limit int) ([]string, error) {
+	query := `SELECT reviewer_id FROM (
+	              SELECT h.history_id, h.reviewer_id 
+	              FROM assignment_history h 
+	              JOIN users u ON u.user_id = h.reviewer_id 
+	              WHERE u.team_name = $1 AND h.action <> 'REMOVED' 
+	              ORDER BY h.history_id DESC 
+	              LIMIT $2
+	          ) recent 
+	          ORDER BY history_id`
+
+	rows, err := r.db.Query(query, teamName, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviewerIDs []string
+	for rows.Next() {
+		var reviewerID string
+		if err := rows.Scan(&reviewerID); err != nil {
+			return nil, err
+		}
+		reviewerIDs = append(reviewerIDs, reviewerID)
+	}
+
+	return reviewerIDs, rows.Err()
+}
+
+func (r *assignmentHistoryRepository) CreateReasoning(reasoning *domain.AssignmentReasoning) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO assignment_reasoning (pull_request_id, action, seed, created_at) 
+	          VALUES ($1, $2, $3, $4) 
+	          RETURNING reasoning_id`
+
+	err = tx.QueryRow(query, reasoning.PRID, string(reasoning.Action), reasoning.Seed, reasoning.CreatedAt.UTC()).Scan(&reasoning.ID)
+	if err != nil {
+		return err
+	}
+
+	for position, decision := range reasoning.Decisions {
+		query := `INSERT INTO assignment_decisions (reasoning_id, position, user_id, status, reason, rank) 
+		          VALUES ($1, $2, $3, $4, $5, $6)`
+
+		_, err := tx.Exec(query, reasoning.ID, position, decision.UserID, string(decision.Status), decision.Reason, decision.Rank)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *assignmentHistoryRepository) GetReasoningByPRID(prID string) ([]*domain.AssignmentReasoning, error) {
+	query := `SELECT reasoning_id, pull_request_id, action, seed, created_at 
+	          FROM assignment_reasoning 
+	          WHERE pull_request_id = $1 
+	          ORDER BY reasoning_id`
+
+	rows, err := r.db.Query(query, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reasonings []*domain.AssignmentReasoning
+	for rows.Next() {
+		var reasoning domain.AssignmentReasoning
+		err := rows.Scan(&reasoning.ID, &reasoning.PRID, &reasoning.Action, &reasoning.Seed, &reasoning.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		reasonings = append(reasonings, &reasoning)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, reasoning := range reasonings {
+		decisions, err := r.getDecisions(reasoning.ID)
+		if err != nil {
+			return nil, err
+		}
+		reasoning.Decisions = decisions
+	}
+
+	return reasonings, nil
+}
+
+func (r *assignmentHistoryRepository) getDecisions(reasoningID int64) ([]domain.CandidateDecision, error) {
+	query := `SELECT user_id, status, reason, rank 
+	          FROM assignment_decisions 
+	          WHERE reasoning_id = $1 
+	          ORDER BY position`
+
+	rows, err := r.db.Query(query, reasoningID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var decisions []domain.CandidateDecision
+	for rows.Next() {
+		var decision domain.CandidateDecision
+		if err := rows.Scan(&decision.UserID, &decision.Status, &decision.Reason, &decision.Rank); err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, rows.Err()
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// querier — общие методы пула соединений и транзакции.
+type querier interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+type txn interface {
+	querier
+	Commit() error
+	Rollback() error
+}
+
+// conn — соединение репозитория: пул или уже открытая транзакция пакетной
+// операции. Begin внутри транзакции открывает точку сохранения, поэтому методы
+// репозиториев работают одинаково в обоих случаях.
+type conn interface {
+	querier
+	Begin() (txn, error)
+}
+
+type dbConn struct {
+	*sql.DB
+}
+
+func (c dbConn) Begin() (txn, error) {
+	return c.DB.Begin()
+}
+
+type txConn struct {
+	*sql.Tx
+	savepoints *int
+}
+
+func newTxConn(tx *sql.Tx) txConn {
+	return txConn{Tx: tx, savepoints: new(int)}
+}
+
+func (c txConn) Begin() (txn, error) {
+	*c.savepoints++
+	sp := &savepoint{tx: c.Tx, name: fmt.Sprintf("sp_%d", *c.savepoints)}
+	if _, err := c.Tx.Exec(`SAVEPOINT ` + sp.name); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+// savepoint повторяет семантику *sql.Tx: Rollback после Commit ничего не делает.
+type savepoint struct {
+	tx   *sql.Tx
+	name string
+	done bool
+}
+
+func (sp *savepoint) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return sp.tx.Exec(query, args...)
+}
+
+func (sp *savepoint) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return sp.tx.Query(query, args...)
+}
+
+func (sp *savepoint) QueryRow(query string, args ...interface{}) *sql.Row {
+	return sp.tx.QueryRow(query, args...)
+}
+
+func (sp *savepoint) Commit() error {
+	if sp.done {
+		return sql.ErrTxDone
+	}
+	sp.done = true
+	_, err := sp.tx.Exec(`RELEASE SAVEPOINT ` + sp.name)
+	return err
+}
+
+func (sp *savepoint) Rollback() error {
+	if sp.done {
+		return sql.ErrTxDone
+	}
+	sp.done = true
+	if _, err := sp.tx.Exec(`ROLLBACK TO SAVEPOINT ` + sp.name); err != nil {
+		return err
+	}
+	_, err := sp.tx.Exec(`RELEASE SAVEPOINT ` + sp.name)
+	return err
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type escalationRepository struct {
+	db conn
+}
+
+func NewEscalationRepository(db *sql.DB) interfaces.EscalationRepository {
+	return &escalationRepository{db: dbConn{db}}
+}
+
+// Create записывает эскалацию. Если эскалацию той же пары PR и ревьюера уже
+// записал другой запуск, возвращает ErrConcurrentUpdate.
+func (r *escalationRepository) Create(escalation *domain.Escalation) error {
+	query := `INSERT INTO escalations (pull_request_id, reviewer_id, action, new_reviewer_id, details, created_at) 
+	          VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6) 
+	          ON CONFLICT (pull_request_id, reviewer_id) DO NOTHING 
+	          RETURNING escalation_id`
+
+	err := r.db.QueryRow(query, escalation.PRID, escalation.ReviewerID, string(escalation.Action),
+		escalation.NewReviewerID, escalation.Details, escalation.CreatedAt.UTC()).Scan(&escalation.ID)
+	if err == sql.ErrNoRows {
+		return domain.ErrConcurrentUpdate
+	}
+	return err
+}
+
+func (r *escalationRepository) GetByPRID(prID string) ([]*domain.Escalation, error) {
+	query := `SELECT escalation_id, pull_request_id, reviewer_id, action, COALESCE(new_reviewer_id, ''), details, created_at 
+	          FROM escalations 
+	          WHERE pull_request_id = $1 
+	          ORDER BY escalation_id`
+
+	rows, err := r.db.Query(query, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var escalations []*domain.Escalation
+	for rows.Next() {
+		var escalation domain.Escalation
+		err := rows.Scan(&escalation.ID, &escalation.PRID, &escalation.ReviewerID, &escalation.Action,
+			&escalation.NewReviewerID, &escalation.Details, &escalation.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		escalations = append(escalations, &escalation)
+	}
+
+	return escalations, rows.Err()
+}
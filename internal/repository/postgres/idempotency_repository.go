@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type idempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewIdempotencyRepository(db *sql.DB) interfaces.IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+func (r *idempotencyRepository) Acquire(record *domain.IdempotencyRecord) (bool, error) {
+	query := `INSERT INTO idempotency_keys (idempotency_key, request_hash, created_at, expires_at) 
+	          VALUES ($1, $2, $3, $4) 
+	          ON CONFLICT (idempotency_key) DO UPDATE 
+	          SET request_hash = EXCLUDED.request_hash, status_code = NULL, response_headers = NULL, 
+	              response_body = NULL, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at 
+	          WHERE idempotency_keys.expires_at <= EXCLUDED.created_at 
+	          RETURNING idempotency_key`
+
+	var key string
+	err := r.db.QueryRow(query, record.Key, record.RequestHash, record.CreatedAt.UTC(), record.ExpiresAt.UTC()).Scan(&key)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *idempotencyRepository) GetByKey(key string) (*domain.IdempotencyRecord, error) {
+	query := `SELECT idempotency_key, request_hash, status_code, response_headers, response_body, created_at, expires_at 
+	          FROM idempotency_keys 
+	          WHERE idempotency_key = $1`
+
+	var record domain.IdempotencyRecord
+	var statusCode sql.NullInt64
+	var headers []byte
+	err := r.db.QueryRow(query, key).Scan(&record.Key, &record.RequestHash, &statusCode, &headers,
+		&record.Body, &record.CreatedAt, &record.ExpiresAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode.Valid {
+		record.Completed = true
+		record.StatusCode = int(statusCode.Int64)
+	}
+	if headers != nil {
+		if err := json.Unmarshal(headers, &record.Headers); err != nil {
+			return nil, err
+		}
+	}
+
+	return &record, nil
+}
+
+func (r *idempotencyRepository) Complete(record *domain.IdempotencyRecord) error {
+	headers, err := json.Marshal(record.Headers)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE idempotency_keys 
+	          SET status_code = $2, response_headers = $3, response_body = $4 
+	          WHERE idempotency_key = $1`
+
+	_, err = r.db.Exec(query, record.Key, record.StatusCode, headers, record.Body)
+	return err
+}
+
+func (r *idempotencyRepository) Delete(key string) error {
+	_, err := r.db.Exec(`DELETE FROM idempotency_keys WHERE idempotency_key = $1`, key)
+	return err
+}
+
+func (r *idempotencyRepository) DeleteExpired(now time.Time) (int, error) {
+	result, err := r.db.Exec(`DELETE FROM idempotency_keys WHERE expires_at <= $1`, now.UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := result.RowsAffected()
+	return int(deleted), err
+}
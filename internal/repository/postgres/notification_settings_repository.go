@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type notificationSettingsRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationSettingsRepository(db *sql.DB) interfaces.NotificationSettingsRepository {
+	return &notificationSettingsRepository{db: db}
+}
+
+func (r *notificationSettingsRepository) Save(settings *domain.NotificationSettings) error {
+	query := `INSERT INTO notification_settings (user_id, email, frequency) 
+	          VALUES ($1, $2, $3) 
+	          ON CONFLICT (user_id) DO UPDATE SET email = EXCLUDED.email, frequency = EXCLUDED.frequency`
+
+	_, err := r.db.Exec(query, settings.UserID, settings.Email, string(settings.Frequency))
+	return err
+}
+
+func (r *notificationSettingsRepository) GetByUserID(userID string) (*domain.NotificationSettings, error) {
+	var settings domain.NotificationSettings
+	var lastSentAt sql.NullTime
+
+	query := `SELECT user_id, email, frequency, last_sent_at 
+	          FROM notification_settings 
+	          WHERE user_id = $1`
+
+	err := r.db.QueryRow(query, userID).Scan(&settings.UserID, &settings.Email, &settings.Frequency, &lastSentAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if lastSentAt.Valid {
+		settings.LastSentAt = &lastSentAt.Time
+	}
+
+	return &settings, nil
+}
+
+func (r *notificationSettingsRepository) MarkSent(userID string, sentAt time.Time) error {
+	query := `INSERT INTO notification_settings (user_id, last_sent_at) 
+	          VALUES ($1, $2) 
+	          ON CONFLICT (user_id) DO UPDATE SET last_sent_at = EXCLUDED.last_sent_at`
+
+	_, err := r.db.Exec(query, userID, sentAt.UTC())
+	return err
+}
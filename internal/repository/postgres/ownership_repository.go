@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type ownershipRepository struct {
+	db *sql.DB
+}
+
+func NewOwnershipRepository(db *sql.DB) interfaces.OwnershipRepository {
+	return &ownershipRepository{db: db}
+}
+
+func (r *ownershipRepository) ReplaceAll(rules []*domain.OwnershipRule) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM ownership_rules`); err != nil {
+		return err
+	}
+
+	for position, rule := range rules {
+		var teamName sql.NullString
+		if rule.TeamName != "" {
+			teamName = sql.NullString{String: rule.TeamName, Valid: true}
+		}
+
+		var ruleID int64
+		query := `INSERT INTO ownership_rules (position, pattern, team_name) 
+		          VALUES ($1, $2, $3) 
+		          RETURNING rule_id`
+		if err := tx.QueryRow(query, position, rule.Pattern, teamName).Scan(&ruleID); err != nil {
+			return err
+		}
+
+		for _, userID := range rule.UserIDs {
+			userQuery := `INSERT INTO ownership_rule_users (rule_id, user_id) 
+			              VALUES ($1, $2)`
+			if _, err := tx.Exec(userQuery, ruleID, userID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *ownershipRepository) GetAll() ([]*domain.OwnershipRule, error) {
+	query := `SELECT rule_id, pattern, team_name 
+	          FROM ownership_rules 
+	          ORDER BY position`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ruleIDs []int64
+	var rules []*domain.OwnershipRule
+	for rows.Next() {
+		var ruleID int64
+		var rule domain.OwnershipRule
+		var teamName sql.NullString
+		if err := rows.Scan(&ruleID, &rule.Pattern, &teamName); err != nil {
+			return nil, err
+		}
+		rule.TeamName = teamName.String
+		ruleIDs = append(ruleIDs, ruleID)
+		rules = append(rules, &rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, ruleID := range ruleIDs {
+		userIDs, err := r.getRuleUsers(ruleID)
+		if err != nil {
+			return nil, err
+		}
+		rules[i].UserIDs = userIDs
+	}
+
+	return rules, nil
+}
+
+func (r *ownershipRepository) getRuleUsers(ruleID int64) ([]string, error) {
+	query := `SELECT user_id FROM ownership_rule_users WHERE rule_id = $1 ORDER BY user_id`
+
+	rows, err := r.db.Query(query, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
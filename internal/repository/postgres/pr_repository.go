@@ -2,18 +2,23 @@ package postgres
 
 import (
 	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/avito-tech-backend-autumn-2025/internal/domain"
 	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
 )
 
 type prRepository struct {
-	db *sql.DB
+	db conn
 }
 
 func NewPRRepository(db *sql.DB) interfaces.PRRepository {
-	return &prRepository{db: db}
+	return &prRepository{db: dbConn{db}}
 }
 
 func (r *prRepository) Create(pr *domain.PullRequest) error {
@@ -23,23 +28,32 @@ func (r *prRepository) Create(pr *domain.PullRequest) error {
 	}
 	defer tx.Rollback()
 
-	query := `INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, merged_at) 
-	          VALUES ($1, $2, $3, $4, $5, $6)`
+	query := `INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, merged_at, updated_at) 
+	          VALUES ($1, $2, $3, $4, $5, $6, $5) 
+	          RETURNING version, updated_at`
 
 	var mergedAt *time.Time
 	if pr.MergedAt != nil {
-		mergedAt = pr.MergedAt
+		merged := pr.MergedAt.UTC()
+		mergedAt = &merged
 	}
 
-	_, err = tx.Exec(query, pr.ID, pr.Name, pr.AuthorID, string(pr.Status), pr.CreatedAt, mergedAt)
+	err = tx.QueryRow(query, pr.ID, pr.Name, pr.AuthorID, string(pr.Status), pr.CreatedAt.UTC(), mergedAt).Scan(&pr.Version, &pr.UpdatedAt)
 	if err != nil {
 		return err
 	}
 
 	for _, reviewerID := range pr.AssignedReviewers {
-		reviewerQuery := `INSERT INTO pr_reviewers (pull_request_id, reviewer_id, assigned_at) 
-		                  VALUES ($1, $2, NOW())`
-		if _, err := tx.Exec(reviewerQuery, pr.ID, reviewerID); err != nil {
+		reviewerQuery := `INSERT INTO pr_reviewers (pull_request_id, reviewer_id, assigned_at, fallback_team) 
+		                  VALUES ($1, $2, $3, NULLIF($4, ''))`
+		if _, err := tx.Exec(reviewerQuery, pr.ID, reviewerID, pr.CreatedAt.UTC(), pr.FallbackTeams[reviewerID]); err != nil {
+			return err
+		}
+	}
+
+	for _, label := range pr.Labels {
+		labelQuery := `INSERT INTO pr_labels (pull_request_id, label) VALUES ($1, $2)`
+		if _, err := tx.Exec(labelQuery, pr.ID, label); err != nil {
 			return err
 		}
 	}
@@ -47,7 +61,11 @@ func (r *prRepository) Create(pr *domain.PullRequest) error {
 	return tx.Commit()
 }
 
-func (r *prRepository) Update(pr *domain.PullRequest) error {
+// Update сохраняет PR, если его версия не менялась с момента чтения, иначе
+// возвращает ErrConcurrentUpdate. Время изменения и назначения новых
+// ревьюеров берётся из at, а не из часов БД: по нему SLA сравнивается с
+// часами приложения. Колонки хранят время без пояса, поэтому оно пишется в UTC.
+func (r *prRepository) Update(pr *domain.PullRequest, at time.Time) error {
 	tx, err := r.db.Begin()
 	if err != nil {
 		return err
@@ -55,28 +73,35 @@ func (r *prRepository) Update(pr *domain.PullRequest) error {
 	defer tx.Rollback()
 
 	query := `UPDATE pull_requests 
-	          SET pull_request_name = $2, status = $3, merged_at = $4 
-	          WHERE pull_request_id = $1`
+	          SET pull_request_name = $2, status = $3, merged_at = $4, version = version + 1, updated_at = $6 
+	          WHERE pull_request_id = $1 AND version = $5 
+	          RETURNING version, updated_at`
 
 	var mergedAt *time.Time
 	if pr.MergedAt != nil {
-		mergedAt = pr.MergedAt
+		merged := pr.MergedAt.UTC()
+		mergedAt = &merged
 	}
 
-	_, err = tx.Exec(query, pr.ID, pr.Name, string(pr.Status), mergedAt)
+	err = tx.QueryRow(query, pr.ID, pr.Name, string(pr.Status), mergedAt, pr.Version, at.UTC()).Scan(&pr.Version, &pr.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return domain.ErrConcurrentUpdate
+	}
 	if err != nil {
 		return err
 	}
 
-	deleteQuery := `DELETE FROM pr_reviewers WHERE pull_request_id = $1`
-	if _, err := tx.Exec(deleteQuery, pr.ID); err != nil {
+	// Оставшиеся ревьюеры сохраняют assigned_at: по нему отслеживается SLA
+	deleteQuery := `DELETE FROM pr_reviewers WHERE pull_request_id = $1 AND NOT (reviewer_id = ANY($2))`
+	if _, err := tx.Exec(deleteQuery, pr.ID, pq.Array(pr.AssignedReviewers)); err != nil {
 		return err
 	}
 
 	for _, reviewerID := range pr.AssignedReviewers {
-		reviewerQuery := `INSERT INTO pr_reviewers (pull_request_id, reviewer_id, assigned_at) 
-		                  VALUES ($1, $2, NOW())`
-		if _, err := tx.Exec(reviewerQuery, pr.ID, reviewerID); err != nil {
+		reviewerQuery := `INSERT INTO pr_reviewers (pull_request_id, reviewer_id, assigned_at, fallback_team) 
+		                  VALUES ($1, $2, $3, NULLIF($4, '')) 
+		                  ON CONFLICT (pull_request_id, reviewer_id) DO NOTHING`
+		if _, err := tx.Exec(reviewerQuery, pr.ID, reviewerID, at.UTC(), pr.FallbackTeams[reviewerID]); err != nil {
 			return err
 		}
 	}
@@ -89,12 +114,12 @@ func (r *prRepository) GetByID(prID string) (*domain.PullRequest, error) {
 	var statusStr string
 	var mergedAt sql.NullTime
 
-	query := `SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at 
+	query := `SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, version, updated_at 
 	          FROM pull_requests 
 	          WHERE pull_request_id = $1`
 
 	err := r.db.QueryRow(query, prID).Scan(
-		&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &pr.CreatedAt, &mergedAt,
+		&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &pr.CreatedAt, &mergedAt, &pr.Version, &pr.UpdatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -108,38 +133,122 @@ func (r *prRepository) GetByID(prID string) (*domain.PullRequest, error) {
 		pr.MergedAt = &mergedAt.Time
 	}
 
-	reviewers, err := r.getReviewers(prID)
-	if err != nil {
+	if err := r.attachDetails([]*domain.PullRequest{&pr}); err != nil {
 		return nil, err
 	}
-	pr.AssignedReviewers = reviewers
 
 	return &pr, nil
 }
 
-func (r *prRepository) getReviewers(prID string) ([]string, error) {
-	query := `SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1 ORDER BY assigned_at`
-
-	rows, err := r.db.Query(query, prID)
+// GetOpenAssignments возвращает ревьюеров всех открытых PR вместе с командой
+// автора и временем назначения.
+func (r *prRepository) GetOpenAssignments() ([]*domain.ReviewAssignment, error) {
+	query := `SELECT pr.pull_request_id, pr.author_id, u.team_name, prr.reviewer_id, prr.assigned_at 
+	          FROM pull_requests pr 
+	          INNER JOIN pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id 
+	          INNER JOIN users u ON u.user_id = pr.author_id 
+	          WHERE pr.status = $1 
+	          ORDER BY prr.assigned_at`
+
+	rows, err := r.db.Query(query, string(domain.StatusOpen))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var reviewers []string
+	var assignments []*domain.ReviewAssignment
 	for rows.Next() {
-		var reviewerID string
-		if err := rows.Scan(&reviewerID); err != nil {
+		var assignment domain.ReviewAssignment
+		if err := rows.Scan(
+			&assignment.PRID, &assignment.AuthorID, &assignment.AuthorTeam, &assignment.ReviewerID, &assignment.AssignedAt,
+		); err != nil {
 			return nil, err
 		}
-		reviewers = append(reviewers, reviewerID)
+		assignments = append(assignments, &assignment)
+	}
+
+	return assignments, rows.Err()
+}
+
+// attachDetails заполняет ревьюеров и метки PR двумя запросами на весь набор.
+func (r *prRepository) attachDetails(prs []*domain.PullRequest) error {
+	if len(prs) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*domain.PullRequest, len(prs))
+	prIDs := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		byID[pr.ID] = pr
+		prIDs = append(prIDs, pr.ID)
+		pr.AssignedReviewers = nil
+		pr.FallbackTeams = make(map[string]string)
+		pr.StaleReviewers = make(map[string]time.Time)
+		pr.Labels = nil
+	}
+
+	if err := r.attachReviewers(byID, prIDs); err != nil {
+		return err
+	}
+
+	return r.attachLabels(byID, prIDs)
+}
+
+// attachReviewers заполняет ревьюеров PR вместе с запасными командами тех, кто
+// назначен из запасного пула, и отметками об устаревших ревью.
+func (r *prRepository) attachReviewers(byID map[string]*domain.PullRequest, prIDs []string) error {
+	query := `SELECT pull_request_id, reviewer_id, COALESCE(fallback_team, ''), stale_since 
+	          FROM pr_reviewers 
+	          WHERE pull_request_id = ANY($1) 
+	          ORDER BY assigned_at`
+
+	rows, err := r.db.Query(query, pq.Array(prIDs))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var prID, reviewerID, fallbackTeam string
+		var staleSince sql.NullTime
+		if err := rows.Scan(&prID, &reviewerID, &fallbackTeam, &staleSince); err != nil {
+			return err
+		}
+		pr := byID[prID]
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewerID)
+		if fallbackTeam != "" {
+			pr.FallbackTeams[reviewerID] = fallbackTeam
+		}
+		if staleSince.Valid {
+			pr.StaleReviewers[reviewerID] = staleSince.Time
+		}
 	}
 
-	return reviewers, rows.Err()
+	return rows.Err()
+}
+
+func (r *prRepository) attachLabels(byID map[string]*domain.PullRequest, prIDs []string) error {
+	query := `SELECT pull_request_id, label FROM pr_labels WHERE pull_request_id = ANY($1) ORDER BY label`
+
+	rows, err := r.db.Query(query, pq.Array(prIDs))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var prID, label string
+		if err := rows.Scan(&prID, &label); err != nil {
+			return err
+		}
+		byID[prID].Labels = append(byID[prID].Labels, label)
+	}
+
+	return rows.Err()
 }
 
 func (r *prRepository) GetByReviewerID(reviewerID string) ([]*domain.PullRequest, error) {
-	query := `SELECT DISTINCT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+	query := `SELECT DISTINCT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version, pr.updated_at
 	          FROM pull_requests pr
 	          INNER JOIN pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id
 	          WHERE prr.reviewer_id = $1
@@ -151,6 +260,152 @@ func (r *prRepository) GetByReviewerID(reviewerID string) ([]*domain.PullRequest
 	}
 	defer rows.Close()
 
+	return r.scanPullRequests(rows)
+}
+
+// GetByIDs возвращает найденные PR из prIDs; отсутствующие пропускаются.
+func (r *prRepository) GetByIDs(prIDs []string) ([]*domain.PullRequest, error) {
+	query := `SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, version, updated_at
+	          FROM pull_requests
+	          WHERE pull_request_id = ANY($1)
+	          ORDER BY created_at, pull_request_id`
+
+	rows, err := r.db.Query(query, pq.Array(prIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanPullRequests(rows)
+}
+
+// GetByReviewerIDs возвращает PR каждого из ревьюеров одним запросом, от
+// новых к старым, как GetByReviewerID.
+func (r *prRepository) GetByReviewerIDs(reviewerIDs []string) (map[string][]*domain.PullRequest, error) {
+	query := `SELECT prr.reviewer_id, pr.pull_request_id
+	          FROM pull_requests pr
+	          INNER JOIN pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id
+	          WHERE prr.reviewer_id = ANY($1)
+	          ORDER BY pr.created_at DESC, pr.pull_request_id`
+
+	rows, err := r.db.Query(query, pq.Array(reviewerIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prIDsByReviewer := make(map[string][]string)
+	var prIDs []string
+	for rows.Next() {
+		var reviewerID, prID string
+		if err := rows.Scan(&reviewerID, &prID); err != nil {
+			return nil, err
+		}
+		prIDsByReviewer[reviewerID] = append(prIDsByReviewer[reviewerID], prID)
+		prIDs = append(prIDs, prID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	prs, err := r.GetByIDs(prIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*domain.PullRequest, len(prs))
+	for _, pr := range prs {
+		byID[pr.ID] = pr
+	}
+
+	result := make(map[string][]*domain.PullRequest, len(prIDsByReviewer))
+	for reviewerID, ids := range prIDsByReviewer {
+		for _, prID := range ids {
+			result[reviewerID] = append(result[reviewerID], byID[prID])
+		}
+	}
+
+	return result, nil
+}
+
+// GetOpen возвращает открытые PR, от старых к новым. С непустым teamName —
+// только PR, автор которых состоит в этой команде.
+func (r *prRepository) GetOpen(teamName string) ([]*domain.PullRequest, error) {
+	query := `SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version, pr.updated_at
+	          FROM pull_requests pr
+	          WHERE pr.status = $1
+	            AND ($2::text = '' OR EXISTS (SELECT 1 FROM users u WHERE u.user_id = pr.author_id AND u.team_name = $2))
+	          ORDER BY pr.created_at, pr.pull_request_id`
+
+	rows, err := r.db.Query(query, string(domain.StatusOpen), teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanPullRequests(rows)
+}
+
+// List возвращает страницу PR под фильтром от новых к старым и общее число
+// PR под фильтром без учёта страницы.
+func (r *prRepository) List(filter domain.PRFilter) ([]*domain.PullRequest, int, error) {
+	var conditions []string
+	var args []interface{}
+	where := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.AuthorID != "" {
+		where("pr.author_id = $%d", filter.AuthorID)
+	}
+	if filter.TeamName != "" {
+		where("EXISTS (SELECT 1 FROM users u WHERE u.user_id = pr.author_id AND u.team_name = $%d)", filter.TeamName)
+	}
+	if filter.ReviewerID != "" {
+		where("EXISTS (SELECT 1 FROM pr_reviewers prr WHERE prr.pull_request_id = pr.pull_request_id AND prr.reviewer_id = $%d)", filter.ReviewerID)
+	}
+	if filter.Status != "" {
+		where("pr.status = $%d", string(filter.Status))
+	}
+	if filter.CreatedFrom != nil {
+		where("pr.created_at >= $%d", filter.CreatedFrom.UTC())
+	}
+	if filter.CreatedTo != nil {
+		where("pr.created_at < $%d", filter.CreatedTo.UTC())
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM pull_requests pr ` + whereClause
+	if err := r.db.QueryRow(countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf(`SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.version, pr.updated_at
+	          FROM pull_requests pr
+	          %s
+	          ORDER BY pr.created_at DESC, pr.pull_request_id DESC
+	          LIMIT $%d OFFSET $%d`, whereClause, len(args)+1, len(args)+2)
+
+	rows, err := r.db.Query(query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	prs, err := r.scanPullRequests(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return prs, total, nil
+}
+
+func (r *prRepository) scanPullRequests(rows *sql.Rows) ([]*domain.PullRequest, error) {
 	var prs []*domain.PullRequest
 	for rows.Next() {
 		var pr domain.PullRequest
@@ -158,7 +413,7 @@ func (r *prRepository) GetByReviewerID(reviewerID string) ([]*domain.PullRequest
 		var mergedAt sql.NullTime
 
 		if err := rows.Scan(
-			&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &pr.CreatedAt, &mergedAt,
+			&pr.ID, &pr.Name, &pr.AuthorID, &statusStr, &pr.CreatedAt, &mergedAt, &pr.Version, &pr.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -168,16 +423,83 @@ func (r *prRepository) GetByReviewerID(reviewerID string) ([]*domain.PullRequest
 			pr.MergedAt = &mergedAt.Time
 		}
 
-		reviewers, err := r.getReviewers(pr.ID)
-		if err != nil {
+		prs = append(prs, &pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Ревьюеры и метки читаются после закрытия курсора, чтобы не держать два
+	// соединения на один запрос
+	if err := r.attachDetails(prs); err != nil {
+		return nil, err
+	}
+
+	return prs, nil
+}
+
+// MarkReviewsStale помечает устаревшими ревью reviewerID во всех открытых PR
+// и возвращает ID этих PR. Уже помеченные ревью сохраняют исходное время.
+func (r *prRepository) MarkReviewsStale(reviewerID string, at time.Time) ([]string, error) {
+	query := `WITH marked AS ( 
+	              UPDATE pr_reviewers prr 
+	              SET stale_since = $3 
+	              FROM pull_requests pr 
+	              WHERE pr.pull_request_id = prr.pull_request_id AND pr.status = $2 AND prr.reviewer_id = $1 
+	                AND prr.stale_since IS NULL 
+	              RETURNING prr.pull_request_id 
+	          ), touched AS ( 
+	              UPDATE pull_requests SET version = version + 1, updated_at = $3 
+	              WHERE pull_request_id IN (SELECT pull_request_id FROM marked) 
+	          ) 
+	          SELECT prr.pull_request_id 
+	          FROM pr_reviewers prr 
+	          INNER JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id 
+	          WHERE pr.status = $2 AND prr.reviewer_id = $1`
+
+	return r.queryPRIDs(query, reviewerID, string(domain.StatusOpen), at.UTC())
+}
+
+// ClearStaleReviews снимает отметку об устаревших ревью reviewerID в открытых
+// PR и возвращает ID PR, где она была.
+func (r *prRepository) ClearStaleReviews(reviewerID string) ([]string, error) {
+	query := `WITH cleared AS ( 
+	              UPDATE pr_reviewers prr 
+	              SET stale_since = NULL 
+	              FROM pull_requests pr 
+	              WHERE pr.pull_request_id = prr.pull_request_id AND pr.status = $2 
+	                AND prr.reviewer_id = $1 AND prr.stale_since IS NOT NULL 
+	              RETURNING prr.pull_request_id 
+	          ), touched AS ( 
+	              UPDATE pull_requests SET version = version + 1, updated_at = NOW() 
+	              WHERE pull_request_id IN (SELECT pull_request_id FROM cleared) 
+	          ) 
+	          SELECT pull_request_id FROM cleared`
+
+	return r.queryPRIDs(query, reviewerID, string(domain.StatusOpen))
+}
+
+func (r *prRepository) queryPRIDs(query string, args ...interface{}) ([]string, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prIDs []string
+	for rows.Next() {
+		var prID string
+		if err := rows.Scan(&prID); err != nil {
 			return nil, err
 		}
-		pr.AssignedReviewers = reviewers
-
-		prs = append(prs, &pr)
+		prIDs = append(prIDs, prID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return prs, rows.Err()
+	sort.Strings(prIDs)
+	return prIDs, nil
 }
 
 func (r *prRepository) Exists(prID string) (bool, error) {
@@ -2,17 +2,20 @@ package postgres
 
 import (
 	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/avito-tech-backend-autumn-2025/internal/domain"
 	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
 )
 
 type teamRepository struct {
-	db *sql.DB
+	db conn
 }
 
 func NewTeamRepository(db *sql.DB) interfaces.TeamRepository {
-	return &teamRepository{db: db}
+	return &teamRepository{db: dbConn{db}}
 }
 
 func (r *teamRepository) Create(team *domain.Team) error {
@@ -31,8 +34,17 @@ func (r *teamRepository) Create(team *domain.Team) error {
 func (r *teamRepository) GetByName(teamName string) (*domain.Team, error) {
 	// Получаем команду
 	var team domain.Team
-	query := `SELECT team_name FROM teams WHERE team_name = $1`
-	err := r.db.QueryRow(query, teamName).Scan(&team.TeamName)
+	var minReviewers sql.NullInt64
+	var minLevel sql.NullString
+	var reviewSLASeconds sql.NullInt64
+	var slaAction sql.NullString
+	query := `SELECT t.team_name, t.version, t.updated_at, t.reviewers_target, sr.min_reviewers, sr.min_level, sp.review_sla_seconds, sp.action 
+	          FROM teams t 
+	          LEFT JOIN team_seniority_rules sr ON sr.team_name = t.team_name 
+	          LEFT JOIN team_sla_policies sp ON sp.team_name = t.team_name 
+	          WHERE t.team_name = $1`
+	err := r.db.QueryRow(query, teamName).Scan(&team.TeamName, &team.Version, &team.UpdatedAt, &team.ReviewersTarget,
+		&minReviewers, &minLevel, &reviewSLASeconds, &slaAction)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -40,17 +52,100 @@ func (r *teamRepository) GetByName(teamName string) (*domain.Team, error) {
 		return nil, err
 	}
 
+	if minReviewers.Valid {
+		team.SeniorityRule = domain.NewSeniorityRule(int(minReviewers.Int64), domain.Seniority(minLevel.String))
+	}
+
+	if reviewSLASeconds.Valid {
+		team.SLAPolicy = &domain.SLAPolicy{
+			ReviewSLA: time.Duration(reviewSLASeconds.Int64) * time.Second,
+			Action:    domain.EscalationAction(slaAction.String),
+		}
+	}
+
 	members, err := r.getTeamMembers(teamName)
 	if err != nil {
 		return nil, err
 	}
 
+	pairingRules, err := r.getPairingRules(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	fallbackTeams, err := r.getFallbackTeams(teamName)
+	if err != nil {
+		return nil, err
+	}
+
 	team.Members = members
+	team.PairingRules = pairingRules
+	team.FallbackTeams = fallbackTeams
 	return &team, nil
 }
 
+// GetAll возвращает все команды по имени с участниками, без правил и
+// запасных команд.
+func (r *teamRepository) GetAll() ([]*domain.Team, error) {
+	rows, err := r.db.Query(`SELECT team_name, version, updated_at, reviewers_target FROM teams ORDER BY team_name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*domain.Team
+	byName := make(map[string]*domain.Team)
+	var teamNames []string
+	for rows.Next() {
+		var team domain.Team
+		if err := rows.Scan(&team.TeamName, &team.Version, &team.UpdatedAt, &team.ReviewersTarget); err != nil {
+			return nil, err
+		}
+		teams = append(teams, &team)
+		byName[team.TeamName] = &team
+		teamNames = append(teamNames, team.TeamName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(teams) == 0 {
+		return teams, nil
+	}
+
+	query := `SELECT ` + userColumns + ` 
+	          FROM users 
+	          WHERE team_name = ANY($1) 
+	          ORDER BY user_id`
+
+	memberRows, err := r.db.Query(query, pq.Array(teamNames))
+	if err != nil {
+		return nil, err
+	}
+	defer memberRows.Close()
+
+	var members []*domain.User
+	for memberRows.Next() {
+		user, err := scanUser(memberRows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, user)
+		byName[user.TeamName].Members = append(byName[user.TeamName].Members, user)
+	}
+	if err := memberRows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := attachAbsences(r.db, members); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
 func (r *teamRepository) getTeamMembers(teamName string) ([]*domain.User, error) {
-	query := `SELECT user_id, username, team_name, is_active 
+	query := `SELECT ` + userColumns + ` 
 	          FROM users 
 	          WHERE team_name = $1`
 
@@ -62,14 +157,221 @@ func (r *teamRepository) getTeamMembers(teamName string) ([]*domain.User, error)
 
 	var members []*domain.User
 	for rows.Next() {
-		var user domain.User
-		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
+		user, err := scanUser(rows)
+		if err != nil {
 			return nil, err
 		}
-		members = append(members, &user)
+		members = append(members, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := attachAbsences(r.db, members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (r *teamRepository) SetSeniorityRule(team *domain.Team, rule *domain.SeniorityRule) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := bumpTeamVersion(tx, team); err != nil {
+		return err
+	}
+
+	if rule == nil {
+		if _, err := tx.Exec(`DELETE FROM team_seniority_rules WHERE team_name = $1`, team.TeamName); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	query := `INSERT INTO team_seniority_rules (team_name, min_reviewers, min_level) 
+	          VALUES ($1, $2, $3) 
+	          ON CONFLICT (team_name) DO UPDATE SET min_reviewers = EXCLUDED.min_reviewers, min_level = EXCLUDED.min_level`
+
+	if _, err := tx.Exec(query, team.TeamName, rule.MinReviewers, string(rule.MinLevel)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *teamRepository) SetSLAPolicy(team *domain.Team, policy *domain.SLAPolicy) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := bumpTeamVersion(tx, team); err != nil {
+		return err
+	}
+
+	if policy == nil {
+		if _, err := tx.Exec(`DELETE FROM team_sla_policies WHERE team_name = $1`, team.TeamName); err != nil {
+			return err
+		}
+		return tx.Commit()
 	}
 
-	return members, rows.Err()
+	query := `INSERT INTO team_sla_policies (team_name, review_sla_seconds, action) 
+	          VALUES ($1, $2, $3) 
+	          ON CONFLICT (team_name) DO UPDATE SET review_sla_seconds = EXCLUDED.review_sla_seconds, action = EXCLUDED.action`
+
+	if _, err := tx.Exec(query, team.TeamName, int64(policy.ReviewSLA/time.Second), string(policy.Action)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *teamRepository) SetReviewersTarget(team *domain.Team, target int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := bumpTeamVersion(tx, team); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`UPDATE teams SET reviewers_target = $2 WHERE team_name = $1`, team.TeamName, target); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *teamRepository) getPairingRules(teamName string) (domain.PairingRules, error) {
+	query := `SELECT rule_type, COALESCE(author_id, ''), reviewer_id 
+	          FROM team_pairing_rules 
+	          WHERE team_name = $1 
+	          ORDER BY rule_id`
+
+	rows, err := r.db.Query(query, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules domain.PairingRules
+	for rows.Next() {
+		var rule domain.PairingRule
+		if err := rows.Scan(&rule.Type, &rule.AuthorID, &rule.ReviewerID); err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+
+	return rules, rows.Err()
+}
+
+func (r *teamRepository) SetPairingRules(team *domain.Team, rules domain.PairingRules) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := bumpTeamVersion(tx, team); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM team_pairing_rules WHERE team_name = $1`, team.TeamName); err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		query := `INSERT INTO team_pairing_rules (team_name, rule_type, author_id, reviewer_id) 
+		          VALUES ($1, $2, NULLIF($3, ''), $4)`
+
+		if _, err := tx.Exec(query, team.TeamName, string(rule.Type), rule.AuthorID, rule.ReviewerID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *teamRepository) getFallbackTeams(teamName string) ([]string, error) {
+	query := `SELECT fallback_team_name 
+	          FROM team_fallbacks 
+	          WHERE team_name = $1 
+	          ORDER BY position`
+
+	rows, err := r.db.Query(query, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fallbackTeams []string
+	for rows.Next() {
+		var fallbackTeam string
+		if err := rows.Scan(&fallbackTeam); err != nil {
+			return nil, err
+		}
+		fallbackTeams = append(fallbackTeams, fallbackTeam)
+	}
+
+	return fallbackTeams, rows.Err()
+}
+
+func (r *teamRepository) SetFallbackTeams(team *domain.Team, fallbackTeams []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := bumpTeamVersion(tx, team); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM team_fallbacks WHERE team_name = $1`, team.TeamName); err != nil {
+		return err
+	}
+
+	for position, fallbackTeam := range fallbackTeams {
+		query := `INSERT INTO team_fallbacks (team_name, position, fallback_team_name) 
+		          VALUES ($1, $2, $3)`
+
+		if _, err := tx.Exec(query, team.TeamName, position, fallbackTeam); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// bumpTeamVersion увеличивает версию команды, если она не менялась с момента
+// чтения team, иначе возвращает ErrConcurrentUpdate.
+func bumpTeamVersion(tx querier, team *domain.Team) error {
+	query := `UPDATE teams SET version = version + 1, updated_at = NOW() 
+	          WHERE team_name = $1 AND version = $2 
+	          RETURNING version, updated_at`
+
+	err := tx.QueryRow(query, team.TeamName, team.Version).Scan(&team.Version, &team.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return domain.ErrConcurrentUpdate
+	}
+	return err
+}
+
+// touchTeams увеличивает версии команд без проверки: так отражаются изменения
+// их участников.
+func touchTeams(tx querier, teamNames ...string) error {
+	query := `UPDATE teams SET version = version + 1, updated_at = NOW() WHERE team_name = ANY($1)`
+	_, err := tx.Exec(query, pq.Array(teamNames))
+	return err
 }
 
 func (r *teamRepository) Exists(teamName string) (bool, error) {
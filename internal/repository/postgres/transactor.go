@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// transactor открывает транзакцию в пуле, а внутри уже открытой транзакции —
+// точку сохранения.
+type transactor struct {
+	db *sql.DB
+	tx *txConn
+}
+
+func NewTransactor(db *sql.DB) interfaces.Transactor {
+	return &transactor{db: db}
+}
+
+func (t *transactor) WithinTx(fn func(repos interfaces.Repositories) error) error {
+	tx, scoped, err := t.begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(repositoriesOf(scoped)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *transactor) begin() (txn, txConn, error) {
+	if t.tx != nil {
+		sp, err := t.tx.Begin()
+		return sp, *t.tx, err
+	}
+
+	tx, err := t.db.Begin()
+	if err != nil {
+		return nil, txConn{}, err
+	}
+	return tx, newTxConn(tx), nil
+}
+
+func repositoriesOf(c txConn) interfaces.Repositories {
+	return interfaces.Repositories{
+		Teams:       &teamRepository{db: c},
+		Users:       &userRepository{db: c},
+		PRs:         &prRepository{db: c},
+		History:     &assignmentHistoryRepository{db: c},
+		Escalations: &escalationRepository{db: c},
+		Tx:          &transactor{tx: &c},
+	}
+}
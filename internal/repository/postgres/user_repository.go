@@ -3,42 +3,104 @@ package postgres
 import (
 	"database/sql"
 
+	"github.com/lib/pq"
+
 	"github.com/avito-tech-backend-autumn-2025/internal/domain"
 	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
 )
 
 type userRepository struct {
-	db *sql.DB
+	db conn
+}
+
+const userTagsColumn = `COALESCE((SELECT array_agg(ut.tag ORDER BY ut.tag) FROM user_tags ut WHERE ut.user_id = users.user_id), '{}')`
+
+const userColumns = `user_id, username, team_name, is_active, seniority, time_zone, work_start_minute, work_end_minute, version, updated_at, ` + userTagsColumn
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(row rowScanner) (*domain.User, error) {
+	var user domain.User
+	var workStart, workEnd sql.NullInt64
+
+	err := row.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Seniority,
+		&user.TimeZone, &workStart, &workEnd, &user.Version, &user.UpdatedAt, pq.Array(&user.Tags))
+	if err != nil {
+		return nil, err
+	}
+
+	if workStart.Valid && workEnd.Valid {
+		user.WorkingHours = &domain.WorkingHours{Start: int(workStart.Int64), End: int(workEnd.Int64)}
+	}
+
+	return &user, nil
+}
+
+func workingHoursArgs(hours *domain.WorkingHours) (sql.NullInt64, sql.NullInt64) {
+	if hours == nil {
+		return sql.NullInt64{}, sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(hours.Start), Valid: true}, sql.NullInt64{Int64: int64(hours.End), Valid: true}
 }
 
 func NewUserRepository(db *sql.DB) interfaces.UserRepository {
-	return &userRepository{db: db}
+	return &userRepository{db: dbConn{db}}
 }
 
+// Create добавляет пользователя и увеличивает версию его команды.
 func (r *userRepository) Create(user *domain.User) error {
-	query := `INSERT INTO users (user_id, username, team_name, is_active, created_at, updated_at) 
-	          VALUES ($1, $2, $3, $4, NOW(), NOW())`
-
-	_, err := r.db.Exec(query, user.UserID, user.Username, user.TeamName, user.IsActive)
-	return err
+	query := `WITH inserted AS ( 
+	              INSERT INTO users (user_id, username, team_name, is_active, seniority, time_zone, work_start_minute, work_end_minute, created_at, updated_at) 
+	              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW()) 
+	              RETURNING team_name, version, updated_at 
+	          ), touched AS ( 
+	              UPDATE teams SET version = version + 1, updated_at = NOW() 
+	              WHERE team_name IN (SELECT team_name FROM inserted) 
+	          ) 
+	          SELECT version, updated_at FROM inserted`
+
+	workStart, workEnd := workingHoursArgs(user.WorkingHours)
+	return r.db.QueryRow(query, user.UserID, user.Username, user.TeamName, user.IsActive, string(user.Seniority),
+		user.TimeZone, workStart, workEnd).Scan(&user.Version, &user.UpdatedAt)
 }
 
+// Update сохраняет пользователя, если его версия не менялась с момента чтения,
+// иначе возвращает ErrConcurrentUpdate. Версии прежней и новой команды
+// пользователя тоже увеличиваются.
 func (r *userRepository) Update(user *domain.User) error {
-	query := `UPDATE users 
-	          SET username = $2, team_name = $3, is_active = $4, updated_at = NOW() 
-	          WHERE user_id = $1`
-
-	_, err := r.db.Exec(query, user.UserID, user.Username, user.TeamName, user.IsActive)
+	query := `WITH previous AS ( 
+	              SELECT team_name FROM users WHERE user_id = $1 
+	          ), updated AS ( 
+	              UPDATE users 
+	              SET username = $2, team_name = $3, is_active = $4, seniority = $5, 
+	                  time_zone = $6, work_start_minute = $7, work_end_minute = $8, 
+	                  version = version + 1, updated_at = NOW() 
+	              WHERE user_id = $1 AND version = $9 
+	              RETURNING team_name, version, updated_at 
+	          ), touched AS ( 
+	              UPDATE teams SET version = version + 1, updated_at = NOW() 
+	              WHERE team_name IN (SELECT team_name FROM updated UNION SELECT team_name FROM previous) 
+	                AND EXISTS (SELECT 1 FROM updated) 
+	          ) 
+	          SELECT version, updated_at FROM updated`
+
+	workStart, workEnd := workingHoursArgs(user.WorkingHours)
+	err := r.db.QueryRow(query, user.UserID, user.Username, user.TeamName, user.IsActive, string(user.Seniority),
+		user.TimeZone, workStart, workEnd, user.Version).Scan(&user.Version, &user.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return domain.ErrConcurrentUpdate
+	}
 	return err
 }
 
 func (r *userRepository) GetByID(userID string) (*domain.User, error) {
-	var user domain.User
-	query := `SELECT user_id, username, team_name, is_active 
+	query := `SELECT ` + userColumns + ` 
 	          FROM users 
 	          WHERE user_id = $1`
 
-	err := r.db.QueryRow(query, userID).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive)
+	user, err := scanUser(r.db.QueryRow(query, userID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -46,15 +108,32 @@ func (r *userRepository) GetByID(userID string) (*domain.User, error) {
 		return nil, err
 	}
 
-	return &user, nil
+	if err := attachAbsences(r.db, []*domain.User{user}); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetByIDs возвращает найденных пользователей из userIDs; отсутствующие пропускаются.
+func (r *userRepository) GetByIDs(userIDs []string) ([]*domain.User, error) {
+	query := `SELECT ` + userColumns + ` 
+	          FROM users 
+	          WHERE user_id = ANY($1)`
+
+	return r.queryUsers(query, pq.Array(userIDs))
 }
 
 func (r *userRepository) GetByTeamName(teamName string) ([]*domain.User, error) {
-	query := `SELECT user_id, username, team_name, is_active 
+	query := `SELECT ` + userColumns + ` 
 	          FROM users 
 	          WHERE team_name = $1`
 
-	rows, err := r.db.Query(query, teamName)
+	return r.queryUsers(query, teamName)
+}
+
+func (r *userRepository) queryUsers(query string, args ...interface{}) ([]*domain.User, error) {
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -62,14 +141,60 @@ func (r *userRepository) GetByTeamName(teamName string) ([]*domain.User, error)
 
 	var users []*domain.User
 	for rows.Next() {
-		var user domain.User
-		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
+		user, err := scanUser(rows)
+		if err != nil {
 			return nil, err
 		}
-		users = append(users, &user)
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := attachAbsences(r.db, users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// SetTags заменяет теги пользователя на user.Tags с той же проверкой версии,
+// что и Update.
+func (r *userRepository) SetTags(user *domain.User) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	versionQuery := `UPDATE users SET version = version + 1, updated_at = NOW() 
+	                 WHERE user_id = $1 AND version = $2 
+	                 RETURNING version, updated_at`
+	err = tx.QueryRow(versionQuery, user.UserID, user.Version).Scan(&user.Version, &user.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return domain.ErrConcurrentUpdate
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := touchTeams(tx, user.TeamName); err != nil {
+		return err
+	}
+
+	deleteQuery := `DELETE FROM user_tags WHERE user_id = $1`
+	if _, err := tx.Exec(deleteQuery, user.UserID); err != nil {
+		return err
+	}
+
+	for _, tag := range user.Tags {
+		tagQuery := `INSERT INTO user_tags (user_id, tag) VALUES ($1, $2)`
+		if _, err := tx.Exec(tagQuery, user.UserID, tag); err != nil {
+			return err
+		}
 	}
 
-	return users, rows.Err()
+	return tx.Commit()
 }
 
 func (r *userRepository) Exists(userID string) (bool, error) {
@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler запускает фоновые задачи с заданным интервалом до отмены контекста.
+// Задача выполняется сразу при старте, а затем по тикеру.
+type Scheduler struct {
+	jobs []Job
+	wg   sync.WaitGroup
+}
+
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// Add регистрирует задачу. Задачи с неположительным интервалом отключены.
+func (s *Scheduler) Add(job Job) {
+	if job.Interval <= 0 {
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.run(ctx, job)
+	}
+}
+
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	log.Printf("Job %s scheduled every %s", job.Name, job.Interval)
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job.Run(ctx); err != nil {
+			log.Printf("Job %s failed: %v", job.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package absence
+
+import (
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type AddAbsenceUseCase struct {
+	absenceRepo interfaces.AbsenceRepository
+	userRepo    interfaces.UserRepository
+}
+
+func NewAddAbsenceUseCase(absenceRepo interfaces.AbsenceRepository, userRepo interfaces.UserRepository) *AddAbsenceUseCase {
+	return &AddAbsenceUseCase{
+		absenceRepo: absenceRepo,
+		userRepo:    userRepo,
+	}
+}
+
+type AddAbsenceRequest struct {
+	UserID    string
+	StartDate time.Time
+	EndDate   time.Time
+	Reason    string
+}
+
+func (uc *AddAbsenceUseCase) Execute(req AddAbsenceRequest) (*domain.Absence, error) {
+	exists, err := uc.userRepo.Exists(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.NotFound(domain.EntityUser, req.UserID)
+	}
+
+	absence, err := domain.NewAbsence(req.UserID, req.StartDate, req.EndDate, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.absenceRepo.Create(absence); err != nil {
+		return nil, err
+	}
+
+	return absence, nil
+}
@@ -0,0 +1,28 @@
+package absence
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type DeleteAbsenceUseCase struct {
+	absenceRepo interfaces.AbsenceRepository
+}
+
+func NewDeleteAbsenceUseCase(absenceRepo interfaces.AbsenceRepository) *DeleteAbsenceUseCase {
+	return &DeleteAbsenceUseCase{
+		absenceRepo: absenceRepo,
+	}
+}
+
+func (uc *DeleteAbsenceUseCase) Execute(absenceID int64) error {
+	absence, err := uc.absenceRepo.GetByID(absenceID)
+	if err != nil {
+		return err
+	}
+	if absence == nil {
+		return domain.NotFound(domain.EntityAbsence, absenceID)
+	}
+
+	return uc.absenceRepo.Delete(absenceID)
+}
@@ -0,0 +1,30 @@
+package absence
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetAbsencesUseCase struct {
+	absenceRepo interfaces.AbsenceRepository
+	userRepo    interfaces.UserRepository
+}
+
+func NewGetAbsencesUseCase(absenceRepo interfaces.AbsenceRepository, userRepo interfaces.UserRepository) *GetAbsencesUseCase {
+	return &GetAbsencesUseCase{
+		absenceRepo: absenceRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (uc *GetAbsencesUseCase) Execute(userID string) ([]*domain.Absence, error) {
+	exists, err := uc.userRepo.Exists(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.NotFound(domain.EntityUser, userID)
+	}
+
+	return uc.absenceRepo.GetByUserID(userID)
+}
@@ -0,0 +1,94 @@
+package absence
+
+import (
+	"errors"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+)
+
+// ReassignAbsentReviewersUseCase переназначает открытые ревью пользователей,
+// которые отсутствуют в указанный день. Запускается фоновой задачей.
+type ReassignAbsentReviewersUseCase struct {
+	absenceRepo     interfaces.AbsenceRepository
+	prRepo          interfaces.PRRepository
+	reassignUseCase *pr.ReassignReviewerUseCase
+}
+
+func NewReassignAbsentReviewersUseCase(
+	absenceRepo interfaces.AbsenceRepository,
+	prRepo interfaces.PRRepository,
+	reassignUseCase *pr.ReassignReviewerUseCase,
+) *ReassignAbsentReviewersUseCase {
+	return &ReassignAbsentReviewersUseCase{
+		absenceRepo:     absenceRepo,
+		prRepo:          prRepo,
+		reassignUseCase: reassignUseCase,
+	}
+}
+
+type ReassignmentResult struct {
+	PRID       string
+	OldUserID  string
+	ReplacedBy string
+	Err        error
+}
+
+// Execute обрабатывает все ещё не обработанные периоды, включающие date, —
+// в том числе начавшиеся в пропущенные запуски или добавленные задним
+// числом. Период отмечается обработанным, когда все его ревью переназначены;
+// если что-то не удалось, следующий запуск повторит только оставшиеся ревью.
+// Ревью, уже снятое с пользователя параллельным запуском, не считается ошибкой.
+func (uc *ReassignAbsentReviewersUseCase) Execute(date time.Time) ([]ReassignmentResult, error) {
+	absences, err := uc.absenceRepo.GetUnprocessedCovering(date)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ReassignmentResult
+	for _, absence := range absences {
+		prs, err := uc.prRepo.GetByReviewerID(absence.UserID)
+		if err != nil {
+			return results, err
+		}
+
+		failed := false
+		for _, pullRequest := range prs {
+			if pullRequest.Status != domain.StatusOpen {
+				continue
+			}
+
+			result := ReassignmentResult{
+				PRID:      pullRequest.ID,
+				OldUserID: absence.UserID,
+			}
+
+			response, err := uc.reassignUseCase.Execute(pr.ReassignReviewerRequest{
+				PRID:      pullRequest.ID,
+				OldUserID: absence.UserID,
+			})
+			if errors.Is(err, domain.ErrNotAssigned) {
+				continue
+			}
+			if err != nil {
+				result.Err = err
+				failed = true
+			} else {
+				result.ReplacedBy = response.ReplacedBy
+			}
+
+			results = append(results, result)
+		}
+
+		if failed {
+			continue
+		}
+		if err := uc.absenceRepo.MarkProcessed(absence.ID, date); err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,157 @@
+package escalation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+)
+
+// EscalateOverdueReviewsUseCase находит ревьюеров открытых PR, нарушивших SLA
+// команды автора, и выполняет действие из политики команды. Для каждой пары
+// PR и ревьюера эскалация выполняется один раз. Запускается фоновой задачей.
+type EscalateOverdueReviewsUseCase struct {
+	transactor    interfaces.Transactor
+	prRepo        interfaces.PRRepository
+	teamRepo      interfaces.TeamRepository
+	reviewer      *domain.ReviewerAssigner
+	clock         domain.Clock
+	defaultPolicy *domain.SLAPolicy
+}
+
+func NewEscalateOverdueReviewsUseCase(
+	transactor interfaces.Transactor,
+	prRepo interfaces.PRRepository,
+	teamRepo interfaces.TeamRepository,
+	reviewer *domain.ReviewerAssigner,
+	clock domain.Clock,
+	defaultPolicy *domain.SLAPolicy,
+) *EscalateOverdueReviewsUseCase {
+	return &EscalateOverdueReviewsUseCase{
+		transactor:    transactor,
+		prRepo:        prRepo,
+		teamRepo:      teamRepo,
+		reviewer:      reviewer,
+		clock:         clock,
+		defaultPolicy: defaultPolicy,
+	}
+}
+
+// Execute эскалирует просроченные ревью. Действие и запись об эскалации
+// выполняются одной транзакцией. Если PR изменили параллельно или эскалацию
+// записал другой запуск, ревью пропускается и проверяется при следующем запуске.
+func (uc *EscalateOverdueReviewsUseCase) Execute() ([]*domain.Escalation, error) {
+	now := uc.clock.Now()
+
+	assignments, err := uc.prRepo.GetOpenAssignments()
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make(map[string]*domain.Team)
+	var escalations []*domain.Escalation
+	for _, assignment := range assignments {
+		team, ok := teams[assignment.AuthorTeam]
+		if !ok {
+			team, err = uc.teamRepo.GetByName(assignment.AuthorTeam)
+			if err != nil {
+				return escalations, err
+			}
+			teams[assignment.AuthorTeam] = team
+		}
+
+		policy := uc.defaultPolicy
+		if team != nil && team.SLAPolicy != nil {
+			policy = team.SLAPolicy
+		}
+		if !policy.IsBreached(assignment.AssignedAt, now) {
+			continue
+		}
+
+		var escalation *domain.Escalation
+		err := uc.transactor.WithinTx(func(repos interfaces.Repositories) error {
+			var err error
+			escalation, err = uc.escalate(repos, assignment, policy.Action, now)
+			return err
+		})
+		if errors.Is(err, domain.ErrConcurrentUpdate) {
+			continue
+		}
+		if err != nil {
+			return escalations, err
+		}
+		if escalation != nil {
+			escalations = append(escalations, escalation)
+		}
+	}
+
+	return escalations, nil
+}
+
+// escalate выполняет действие политики и записывает эскалацию; если ревью уже
+// эскалировано, возвращает nil. ADD_REVIEWER добавляет на PR не больше
+// MaxEscalationReviewers ревьюеров. Если добавить или заменить ревьюера нельзя
+// по доменным причинам, эскалация сводится к событию NOTIFY; конфликт
+// параллельного изменения возвращается как есть, чтобы откатить транзакцию.
+func (uc *EscalateOverdueReviewsUseCase) escalate(repos interfaces.Repositories, assignment *domain.ReviewAssignment, action domain.EscalationAction, now time.Time) (*domain.Escalation, error) {
+	previous, err := repos.Escalations.GetByPRID(assignment.PRID)
+	if err != nil {
+		return nil, err
+	}
+
+	added := 0
+	for _, escalation := range previous {
+		if escalation.ReviewerID == assignment.ReviewerID {
+			return nil, nil
+		}
+		if escalation.Action == domain.EscalationAddReviewer {
+			added++
+		}
+	}
+
+	escalation := domain.NewEscalation(assignment.PRID, assignment.ReviewerID, action, now)
+
+	var newReviewerID string
+	switch action {
+	case domain.EscalationAddReviewer:
+		if added >= domain.MaxEscalationReviewers {
+			escalation.Action = domain.EscalationNotify
+			escalation.Details = fmt.Sprintf("%s skipped: %d reviewer(s) already added to the PR by escalation", action, added)
+			break
+		}
+		addExtra := pr.NewAddExtraReviewerUseCase(repos.Tx, repos.PRs, repos.Users, repos.Teams, repos.History, uc.reviewer, uc.clock)
+		var response *pr.AddExtraReviewerResponse
+		response, err = addExtra.Execute(pr.AddExtraReviewerRequest{PRID: assignment.PRID})
+		if err == nil {
+			newReviewerID = response.ReviewerID
+		}
+	case domain.EscalationReplaceReviewer:
+		reassign := pr.NewReassignReviewerUseCase(repos.Tx, repos.PRs, repos.Users, repos.Teams, repos.History, uc.reviewer, uc.clock)
+		var response *pr.ReassignReviewerResponse
+		response, err = reassign.Execute(pr.ReassignReviewerRequest{
+			PRID:      assignment.PRID,
+			OldUserID: assignment.ReviewerID,
+		})
+		if err == nil {
+			newReviewerID = response.ReplacedBy
+		}
+	}
+
+	if err != nil {
+		if errors.Is(err, domain.ErrConcurrentUpdate) || !errors.As(err, new(*domain.DomainError)) {
+			return nil, err
+		}
+		escalation.Action = domain.EscalationNotify
+		escalation.Details = fmt.Sprintf("%s failed: %v", action, err)
+	} else {
+		escalation.NewReviewerID = newReviewerID
+	}
+
+	if err := repos.Escalations.Create(escalation); err != nil {
+		return nil, err
+	}
+	return escalation, nil
+}
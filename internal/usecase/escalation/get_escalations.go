@@ -0,0 +1,30 @@
+package escalation
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetEscalationsUseCase struct {
+	prRepo         interfaces.PRRepository
+	escalationRepo interfaces.EscalationRepository
+}
+
+func NewGetEscalationsUseCase(prRepo interfaces.PRRepository, escalationRepo interfaces.EscalationRepository) *GetEscalationsUseCase {
+	return &GetEscalationsUseCase{
+		prRepo:         prRepo,
+		escalationRepo: escalationRepo,
+	}
+}
+
+func (uc *GetEscalationsUseCase) Execute(prID string) ([]*domain.Escalation, error) {
+	exists, err := uc.prRepo.Exists(prID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.NotFound(domain.EntityPullRequest, prID)
+	}
+
+	return uc.escalationRepo.GetByPRID(prID)
+}
@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// MaxKeyLength — ограничение длины Idempotency-Key, как у колонки в БД.
+const MaxKeyLength = 255
+
+type BeginUseCase struct {
+	idempotencyRepo interfaces.IdempotencyRepository
+	clock           domain.Clock
+	ttl             time.Duration
+}
+
+func NewBeginUseCase(idempotencyRepo interfaces.IdempotencyRepository, clock domain.Clock, ttl time.Duration) *BeginUseCase {
+	return &BeginUseCase{
+		idempotencyRepo: idempotencyRepo,
+		clock:           clock,
+		ttl:             ttl,
+	}
+}
+
+type BeginRequest struct {
+	Key         string
+	RequestHash string
+}
+
+// Execute занимает ключ под новый запрос. Если ключ уже использован тем же
+// запросом, возвращает сохранённую запись с Completed = true — её ответ
+// нужно повторить, а запрос не выполнять.
+func (uc *BeginUseCase) Execute(req BeginRequest) (*domain.IdempotencyRecord, error) {
+	if req.Key == "" || len(req.Key) > MaxKeyLength {
+		return nil, domain.InvalidArgument("Idempotency-Key", fmt.Sprintf("must contain from 1 to %d characters", MaxKeyLength))
+	}
+
+	// Вторая попытка нужна, если запись удалили между Acquire и GetByKey:
+	// запрос-владелец завершился ошибкой или ключ истёк
+	for attempt := 0; attempt < 2; attempt++ {
+		record := domain.NewIdempotencyRecord(req.Key, req.RequestHash, uc.clock.Now(), uc.ttl)
+		acquired, err := uc.idempotencyRepo.Acquire(record)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			return record, nil
+		}
+
+		stored, err := uc.idempotencyRepo.GetByKey(req.Key)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if stored.RequestHash != req.RequestHash {
+			return nil, domain.ErrIdempotencyKeyReused
+		}
+		if !stored.Completed {
+			return nil, domain.ErrIdempotencyKeyInProgress
+		}
+		return stored, nil
+	}
+
+	return nil, domain.ErrIdempotencyKeyInProgress
+}
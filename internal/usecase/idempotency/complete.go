@@ -0,0 +1,36 @@
+package idempotency
+
+import (
+	"net/http"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type CompleteUseCase struct {
+	idempotencyRepo interfaces.IdempotencyRepository
+}
+
+func NewCompleteUseCase(idempotencyRepo interfaces.IdempotencyRepository) *CompleteUseCase {
+	return &CompleteUseCase{
+		idempotencyRepo: idempotencyRepo,
+	}
+}
+
+type CompleteRequest struct {
+	Record     *domain.IdempotencyRecord
+	StatusCode int
+	Headers    http.Header
+	Body       []byte
+}
+
+// Execute сохраняет ответ на запрос, занявший ключ. Ответ 5xx не сохраняется:
+// ключ освобождается, чтобы повтор выполнил запрос заново.
+func (uc *CompleteUseCase) Execute(req CompleteRequest) error {
+	if req.StatusCode >= http.StatusInternalServerError {
+		return uc.idempotencyRepo.Delete(req.Record.Key)
+	}
+
+	req.Record.Complete(req.StatusCode, req.Headers, req.Body)
+	return uc.idempotencyRepo.Complete(req.Record)
+}
@@ -0,0 +1,23 @@
+package idempotency
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type PurgeExpiredUseCase struct {
+	idempotencyRepo interfaces.IdempotencyRepository
+	clock           domain.Clock
+}
+
+func NewPurgeExpiredUseCase(idempotencyRepo interfaces.IdempotencyRepository, clock domain.Clock) *PurgeExpiredUseCase {
+	return &PurgeExpiredUseCase{
+		idempotencyRepo: idempotencyRepo,
+		clock:           clock,
+	}
+}
+
+// Execute удаляет истёкшие ключи и возвращает их число.
+func (uc *PurgeExpiredUseCase) Execute() (int, error) {
+	return uc.idempotencyRepo.DeleteExpired(uc.clock.Now())
+}
@@ -0,0 +1,29 @@
+package ownership
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type ExplainUseCase struct {
+	ownershipRepo interfaces.OwnershipRepository
+}
+
+func NewExplainUseCase(ownershipRepo interfaces.OwnershipRepository) *ExplainUseCase {
+	return &ExplainUseCase{
+		ownershipRepo: ownershipRepo,
+	}
+}
+
+type ExplainRequest struct {
+	ChangedFiles []string
+}
+
+func (uc *ExplainUseCase) Execute(req ExplainRequest) ([]domain.FileOwnership, error) {
+	rules, err := uc.ownershipRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ExplainOwnership(rules, req.ChangedFiles), nil
+}
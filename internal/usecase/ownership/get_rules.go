@@ -0,0 +1,20 @@
+package ownership
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetRulesUseCase struct {
+	ownershipRepo interfaces.OwnershipRepository
+}
+
+func NewGetRulesUseCase(ownershipRepo interfaces.OwnershipRepository) *GetRulesUseCase {
+	return &GetRulesUseCase{
+		ownershipRepo: ownershipRepo,
+	}
+}
+
+func (uc *GetRulesUseCase) Execute() ([]*domain.OwnershipRule, error) {
+	return uc.ownershipRepo.GetAll()
+}
@@ -0,0 +1,76 @@
+package ownership
+
+import (
+	"strings"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetRulesUseCase struct {
+	ownershipRepo interfaces.OwnershipRepository
+	teamRepo      interfaces.TeamRepository
+	userRepo      interfaces.UserRepository
+}
+
+func NewSetRulesUseCase(
+	ownershipRepo interfaces.OwnershipRepository,
+	teamRepo interfaces.TeamRepository,
+	userRepo interfaces.UserRepository,
+) *SetRulesUseCase {
+	return &SetRulesUseCase{
+		ownershipRepo: ownershipRepo,
+		teamRepo:      teamRepo,
+		userRepo:      userRepo,
+	}
+}
+
+type SetRulesRequest struct {
+	Rules []RuleRequest
+}
+
+type RuleRequest struct {
+	Pattern  string
+	TeamName string
+	UserIDs  []string
+}
+
+func (uc *SetRulesUseCase) Execute(req SetRulesRequest) ([]*domain.OwnershipRule, error) {
+	rules := make([]*domain.OwnershipRule, 0, len(req.Rules))
+	for _, ruleReq := range req.Rules {
+		if strings.TrimSpace(ruleReq.Pattern) == "" {
+			return nil, domain.InvalidArgument("pattern", "is required")
+		}
+		if ruleReq.TeamName == "" && len(ruleReq.UserIDs) == 0 {
+			return nil, domain.InvalidArgument("user_ids", "team_name or user_ids is required")
+		}
+
+		if ruleReq.TeamName != "" {
+			exists, err := uc.teamRepo.Exists(ruleReq.TeamName)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, domain.NotFound(domain.EntityTeam, ruleReq.TeamName)
+			}
+		}
+
+		for _, userID := range ruleReq.UserIDs {
+			exists, err := uc.userRepo.Exists(userID)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, domain.NotFound(domain.EntityUser, userID)
+			}
+		}
+
+		rules = append(rules, domain.NewOwnershipRule(ruleReq.Pattern, ruleReq.TeamName, ruleReq.UserIDs))
+	}
+
+	if err := uc.ownershipRepo.ReplaceAll(rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,172 @@
+package pr
+
+import (
+	"errors"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// AddExtraReviewerUseCase добавляет на открытый PR ревьюера, подобранного
+// назначателем, в дополнение к уже назначенным. Кандидаты ищутся в команде
+// автора, затем в её запасных командах, по её правилам старшинства и пар. На
+// нём построены добор ревьюеров и эскалация просроченных ревью.
+type AddExtraReviewerUseCase struct {
+	transactor  interfaces.Transactor
+	prRepo      interfaces.PRRepository
+	userRepo    interfaces.UserRepository
+	teamRepo    interfaces.TeamRepository
+	historyRepo interfaces.AssignmentHistoryRepository
+	reviewer    *domain.ReviewerAssigner
+	clock       domain.Clock
+}
+
+func NewAddExtraReviewerUseCase(
+	transactor interfaces.Transactor,
+	prRepo interfaces.PRRepository,
+	userRepo interfaces.UserRepository,
+	teamRepo interfaces.TeamRepository,
+	historyRepo interfaces.AssignmentHistoryRepository,
+	reviewer *domain.ReviewerAssigner,
+	clock domain.Clock,
+) *AddExtraReviewerUseCase {
+	return &AddExtraReviewerUseCase{
+		transactor:  transactor,
+		prRepo:      prRepo,
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		historyRepo: historyRepo,
+		reviewer:    reviewer,
+		clock:       clock,
+	}
+}
+
+type AddExtraReviewerRequest struct {
+	PRID string
+}
+
+type AddExtraReviewerResponse struct {
+	PR         *domain.PullRequest
+	ReviewerID string
+}
+
+func (uc *AddExtraReviewerUseCase) Execute(req AddExtraReviewerRequest) (*AddExtraReviewerResponse, error) {
+	pr, err := uc.prRepo.GetByID(req.PRID)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, domain.NotFound(domain.EntityPullRequest, req.PRID)
+	}
+
+	if !pr.CanReassign() {
+		return nil, pr.MergedError()
+	}
+
+	added, err := uc.addReviewers(pr, 1, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(added) == 0 {
+		return nil, domain.ErrNoCandidate.WithDetails(domain.Details{"pull_request_id": pr.ID})
+	}
+
+	return &AddExtraReviewerResponse{
+		PR:         pr,
+		ReviewerID: added[0],
+	}, nil
+}
+
+// addReviewers добавляет на PR до count ревьюеров и сохраняет их одной
+// транзакцией. Если кандидаты кончились раньше, добавляет сколько нашлось.
+// Непустой onlyUserID оставляет единственным кандидатом этого участника
+// команды автора, без запасных команд.
+func (uc *AddExtraReviewerUseCase) addReviewers(pr *domain.PullRequest, count int, onlyUserID string) ([]string, error) {
+	team, err := loadAuthorTeam(uc.userRepo, uc.teamRepo, pr.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	var fallbackTeams []*domain.Team
+	candidates := team
+	if onlyUserID != "" {
+		candidates = withOnlyMember(team, onlyUserID)
+	} else {
+		fallbackTeams, err = loadFallbackTeams(uc.teamRepo, team)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recent, err := recentReviewerIDs(uc.historyRepo, uc.reviewer, team.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers, err := loadUsers(uc.userRepo, pr.AssignedReviewers)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	var added []string
+	var records []*domain.AssignmentRecord
+	var reasonings []*domain.AssignmentReasoning
+	for len(added) < count {
+		excludeIDs := []string{pr.AuthorID}
+		excludeIDs = append(excludeIDs, pr.AssignedReviewers...)
+
+		replacement, err := uc.reviewer.FindReplacementCandidate(domain.ReplacementRequest{
+			Team:              candidates,
+			AuthorID:          pr.AuthorID,
+			Reviewers:         reviewers,
+			ExcludeUserIDs:    excludeIDs,
+			Labels:            pr.Labels,
+			SeniorityRule:     team.SeniorityRule,
+			PairingRules:      team.PairingRules,
+			RecentReviewerIDs: recent,
+			FallbackTeams:     fallbackTeams,
+		})
+		if errors.Is(err, domain.ErrNoCandidate) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		reviewerID := replacement.Reviewer.UserID
+		if err := pr.AddReviewer(reviewerID); err != nil {
+			return nil, err
+		}
+		pr.SetFallbackTeam(reviewerID, replacement.FallbackTeam)
+		reviewers = append(reviewers, replacement.Reviewer)
+		recent = append(recent, reviewerID)
+
+		added = append(added, reviewerID)
+		records = append(records, domain.NewAssignmentRecord(pr.ID, domain.ActionAssigned, reviewerID, replacement.Seed, now))
+		reasonings = append(reasonings, domain.NewAssignmentReasoning(pr.ID, domain.ActionAssigned, replacement.Seed, replacement.Decisions, now))
+	}
+
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	if err := saveReviewers(uc.transactor, pr, now, records, reasonings); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// withOnlyMember возвращает копию команды, в которой из участников остался
+// только userID.
+func withOnlyMember(team *domain.Team, userID string) *domain.Team {
+	only := *team
+	only.Members = nil
+	for _, member := range team.Members {
+		if member.UserID == userID {
+			only.Members = append(only.Members, member)
+		}
+	}
+	return &only
+}
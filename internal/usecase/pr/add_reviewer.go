@@ -0,0 +1,86 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// AddReviewerUseCase назначает на PR указанного ревьюера в дополнение к уже
+// назначенным.
+type AddReviewerUseCase struct {
+	transactor interfaces.Transactor
+	prRepo     interfaces.PRRepository
+	userRepo   interfaces.UserRepository
+	teamRepo   interfaces.TeamRepository
+	clock      domain.Clock
+}
+
+func NewAddReviewerUseCase(
+	transactor interfaces.Transactor,
+	prRepo interfaces.PRRepository,
+	userRepo interfaces.UserRepository,
+	teamRepo interfaces.TeamRepository,
+	clock domain.Clock,
+) *AddReviewerUseCase {
+	return &AddReviewerUseCase{
+		transactor: transactor,
+		prRepo:     prRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		clock:      clock,
+	}
+}
+
+type AddReviewerRequest struct {
+	PRID    string
+	UserID  string
+	IfMatch *int64
+}
+
+func (uc *AddReviewerUseCase) Execute(req AddReviewerRequest) (*domain.PullRequest, error) {
+	pr, err := uc.prRepo.GetByID(req.PRID)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, domain.NotFound(domain.EntityPullRequest, req.PRID)
+	}
+
+	if err := domain.CheckVersion(pr.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	if !pr.CanReassign() {
+		return nil, pr.MergedError()
+	}
+
+	reviewer, err := uc.userRepo.GetByID(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if reviewer == nil {
+		return nil, domain.NotFound(domain.EntityUser, req.UserID)
+	}
+
+	authorTeam, err := loadAuthorTeam(uc.userRepo, uc.teamRepo, pr.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	if err := pr.CheckReviewer(reviewer, authorTeam.TeamName, authorTeam.PairingRules, now); err != nil {
+		return nil, err
+	}
+
+	if err := pr.AddReviewer(reviewer.UserID); err != nil {
+		return nil, err
+	}
+
+	record := domain.NewAssignmentRecord(pr.ID, domain.ActionAssigned, reviewer.UserID, 0, now)
+	reasoning := requestedReasoning(pr.ID, domain.ActionAssigned, reviewer.UserID, now)
+	if err := saveReviewers(uc.transactor, pr, now, []*domain.AssignmentRecord{record}, []*domain.AssignmentReasoning{reasoning}); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
@@ -0,0 +1,96 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// BackfillReviewersUseCase добирает ревьюеров открытым PR, у которых их меньше
+// цели команды автора, — например, если PR создан, когда в команде был один
+// свободный участник. Цель та же, что при создании PR (Team.TargetReviewers).
+// Запускается вручную и фоновой задачей.
+type BackfillReviewersUseCase struct {
+	prRepo          interfaces.PRRepository
+	addExtraUseCase *AddExtraReviewerUseCase
+}
+
+func NewBackfillReviewersUseCase(
+	prRepo interfaces.PRRepository,
+	addExtraUseCase *AddExtraReviewerUseCase,
+) *BackfillReviewersUseCase {
+	return &BackfillReviewersUseCase{
+		prRepo:          prRepo,
+		addExtraUseCase: addExtraUseCase,
+	}
+}
+
+// BackfillResult — изменения одного PR. Missing — сколько ревьюеров всё ещё не
+// хватает; Err — причина, по которой добор не удался.
+type BackfillResult struct {
+	PRID           string
+	AddedReviewers []string
+	FallbackTeams  map[string]string
+	Missing        int
+	Err            error
+}
+
+// BackfillReviewersRequest — TeamName ограничивает добор PR авторов одной
+// команды; пустой — все открытые PR. Непустой ReviewerID добавляет на каждый
+// PR только этого участника команды.
+type BackfillReviewersRequest struct {
+	TeamName   string
+	ReviewerID string
+}
+
+type BackfillReport struct {
+	Scanned int
+	Results []BackfillResult
+}
+
+// Execute проходит по открытым PR и возвращает отчёт только по тем, кому не
+// хватало ревьюеров. Каждый PR дополняется своей транзакцией, поэтому ошибка
+// на одном PR попадает в его результат и не прерывает остальные.
+func (uc *BackfillReviewersUseCase) Execute(req BackfillReviewersRequest) (*BackfillReport, error) {
+	prs, err := uc.prRepo.GetOpen(req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &BackfillReport{Scanned: len(prs)}
+	teams := make(map[string]*domain.Team)
+	for _, pullRequest := range prs {
+		team, ok := teams[pullRequest.AuthorID]
+		if !ok {
+			team, err = loadAuthorTeam(uc.addExtraUseCase.userRepo, uc.addExtraUseCase.teamRepo, pullRequest.AuthorID)
+			if err != nil {
+				report.Results = append(report.Results, BackfillResult{PRID: pullRequest.ID, Err: err})
+				continue
+			}
+			teams[pullRequest.AuthorID] = team
+		}
+
+		target := team.TargetReviewers()
+		missing := pullRequest.MissingReviewers(target)
+		if missing == 0 {
+			continue
+		}
+
+		count := missing
+		if req.ReviewerID != "" {
+			count = 1
+		}
+
+		result := BackfillResult{PRID: pullRequest.ID, Missing: missing}
+		added, err := uc.addExtraUseCase.addReviewers(pullRequest, count, req.ReviewerID)
+		if err != nil {
+			result.Err = err
+		} else {
+			result.AddedReviewers = added
+			result.FallbackTeams = pullRequest.FallbackTeams
+			result.Missing = pullRequest.MissingReviewers(target)
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,145 @@
+package pr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+const (
+	MaxBatchSize = 500
+	// BatchChunkSize — элементов в одной транзакции в режиме BEST_EFFORT
+	BatchChunkSize = 50
+)
+
+// Итог одного элемента пакета.
+const (
+	BatchItemSucceeded  = "SUCCEEDED"
+	BatchItemFailed     = "FAILED"
+	BatchItemRolledBack = "ROLLED_BACK"
+	BatchItemSkipped    = "SKIPPED"
+)
+
+// BatchItemResult — итог элемента пакета. Err — доменная причина, по которой
+// элемент не выполнился; PR и ReplacedBy заполнены только у SUCCEEDED.
+type BatchItemResult struct {
+	Index      int
+	PRID       string
+	Status     string
+	PR         *domain.PullRequest
+	ReplacedBy string
+	Err        error
+}
+
+// BatchResult — итог пакета. Err — ошибка, не относящаяся к элементу, на
+// которой остановился пакет BEST_EFFORT.
+type BatchResult struct {
+	Mode  domain.BatchMode
+	Items []BatchItemResult
+	Err   error
+}
+
+// batchItem выполняет элемент пакета через репозитории его транзакции и
+// заполняет результат.
+type batchItem func(repos interfaces.Repositories, item *BatchItemResult) error
+
+var errBatchAborted = errors.New("batch aborted")
+
+// runBatch выполняет элементы по порядку, каждый в своей точке сохранения.
+// BEST_EFFORT фиксирует пакет частями по BatchChunkSize, и ошибка элемента
+// откатывает только его. Ошибка, не относящаяся к элементу (например, БД),
+// откатывает текущую часть и останавливает пакет с этой ошибкой в Err: уже
+// зафиксированные части остаются в отчёте, выполненные элементы текущей части
+// становятся ROLLED_BACK, элемент с ошибкой — FAILED, оставшиеся — SKIPPED.
+// ALL_OR_NOTHING выполняет пакет одной транзакцией и на первой ошибке
+// откатывает её: выполненные элементы становятся ROLLED_BACK, оставшиеся —
+// SKIPPED. Там ошибки, не относящиеся к элементу, прерывают пакет.
+func runBatch(transactor interfaces.Transactor, modeValue string, prIDs []string, run batchItem) (*BatchResult, error) {
+	mode, err := domain.ParseBatchMode(modeValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(prIDs) == 0 || len(prIDs) > MaxBatchSize {
+		return nil, domain.InvalidArgument("items", fmt.Sprintf("must contain from 1 to %d items", MaxBatchSize))
+	}
+
+	result := &BatchResult{Mode: mode, Items: make([]BatchItemResult, len(prIDs))}
+	for i, prID := range prIDs {
+		result.Items[i] = BatchItemResult{Index: i, PRID: prID, Status: BatchItemSkipped}
+	}
+
+	if mode == domain.BatchAllOrNothing {
+		err := transactor.WithinTx(func(repos interfaces.Repositories) error {
+			return runChunk(repos, result.Items, run, true)
+		})
+		if err == errBatchAborted {
+			rollBack(result.Items)
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	for start := 0; start < len(result.Items); start += BatchChunkSize {
+		chunk := result.Items[start:min(start+BatchChunkSize, len(result.Items))]
+		err := transactor.WithinTx(func(repos interfaces.Repositories) error {
+			return runChunk(repos, chunk, run, false)
+		})
+		if err != nil {
+			rollBack(chunk)
+			result.Err = err
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// rollBack отмечает выполненные элементы откаченной транзакции.
+func rollBack(items []BatchItemResult) {
+	for i := range items {
+		item := &items[i]
+		if item.Status == BatchItemSucceeded {
+			item.Status = BatchItemRolledBack
+			item.PR = nil
+			item.ReplacedBy = ""
+		}
+	}
+}
+
+func runChunk(repos interfaces.Repositories, items []BatchItemResult, run batchItem, stopOnError bool) error {
+	for i := range items {
+		item := &items[i]
+		err := repos.Tx.WithinTx(func(itemRepos interfaces.Repositories) error {
+			return run(itemRepos, item)
+		})
+		if err == nil {
+			item.Status = BatchItemSucceeded
+			continue
+		}
+
+		item.Status = BatchItemFailed
+		item.PR = nil
+		item.ReplacedBy = ""
+		item.Err = err
+		if !isBatchItemError(err) {
+			return err
+		}
+		if stopOnError {
+			return errBatchAborted
+		}
+	}
+	return nil
+}
+
+// isBatchItemError — доменные причины, по которым не выполняется отдельный
+// элемент; остальной пакет от них не зависит. Прочие ошибки (например, БД)
+// прерывают пакет.
+func isBatchItemError(err error) bool {
+	var domainErr *domain.DomainError
+	return errors.As(err, &domainErr)
+}
@@ -0,0 +1,51 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// BatchCreatePRsUseCase создаёт PR пакетом с тем же назначением ревьюеров,
+// что и CreatePRUseCase. Следующие PR пакета учитывают назначения предыдущих.
+type BatchCreatePRsUseCase struct {
+	transactor    interfaces.Transactor
+	ownershipRepo interfaces.OwnershipRepository
+	reviewer      *domain.ReviewerAssigner
+	clock         domain.Clock
+}
+
+func NewBatchCreatePRsUseCase(
+	transactor interfaces.Transactor,
+	ownershipRepo interfaces.OwnershipRepository,
+	reviewer *domain.ReviewerAssigner,
+	clock domain.Clock,
+) *BatchCreatePRsUseCase {
+	return &BatchCreatePRsUseCase{
+		transactor:    transactor,
+		ownershipRepo: ownershipRepo,
+		reviewer:      reviewer,
+		clock:         clock,
+	}
+}
+
+type BatchCreatePRsRequest struct {
+	Mode  string
+	Items []CreatePRRequest
+}
+
+func (uc *BatchCreatePRsUseCase) Execute(req BatchCreatePRsRequest) (*BatchResult, error) {
+	prIDs := make([]string, len(req.Items))
+	for i, item := range req.Items {
+		prIDs[i] = item.PRID
+	}
+
+	return runBatch(uc.transactor, req.Mode, prIDs, func(repos interfaces.Repositories, item *BatchItemResult) error {
+		create := NewCreatePRUseCase(repos.Tx, repos.PRs, repos.Users, repos.Teams, uc.ownershipRepo, repos.History, uc.reviewer, uc.clock)
+		pr, err := create.Execute(req.Items[item.Index])
+		if err != nil {
+			return err
+		}
+		item.PR = pr
+		return nil
+	})
+}
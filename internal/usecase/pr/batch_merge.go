@@ -0,0 +1,40 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type BatchMergePRsUseCase struct {
+	transactor interfaces.Transactor
+	clock      domain.Clock
+}
+
+func NewBatchMergePRsUseCase(transactor interfaces.Transactor, clock domain.Clock) *BatchMergePRsUseCase {
+	return &BatchMergePRsUseCase{
+		transactor: transactor,
+		clock:      clock,
+	}
+}
+
+type BatchMergePRsRequest struct {
+	Mode  string
+	Items []MergePRRequest
+}
+
+// Execute мержит PR пакетом. Как и одиночный merge, повторный merge успешен.
+func (uc *BatchMergePRsUseCase) Execute(req BatchMergePRsRequest) (*BatchResult, error) {
+	prIDs := make([]string, len(req.Items))
+	for i, item := range req.Items {
+		prIDs[i] = item.PRID
+	}
+
+	return runBatch(uc.transactor, req.Mode, prIDs, func(repos interfaces.Repositories, item *BatchItemResult) error {
+		pr, err := NewMergePRUseCase(repos.PRs, uc.clock).Execute(req.Items[item.Index])
+		if err != nil {
+			return err
+		}
+		item.PR = pr
+		return nil
+	})
+}
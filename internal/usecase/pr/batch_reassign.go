@@ -0,0 +1,49 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type BatchReassignReviewersUseCase struct {
+	transactor interfaces.Transactor
+	reviewer   *domain.ReviewerAssigner
+	clock      domain.Clock
+}
+
+func NewBatchReassignReviewersUseCase(
+	transactor interfaces.Transactor,
+	reviewer *domain.ReviewerAssigner,
+	clock domain.Clock,
+) *BatchReassignReviewersUseCase {
+	return &BatchReassignReviewersUseCase{
+		transactor: transactor,
+		reviewer:   reviewer,
+		clock:      clock,
+	}
+}
+
+type BatchReassignReviewersRequest struct {
+	Mode  string
+	Items []ReassignReviewerRequest
+}
+
+// Execute переназначает ревьюеров пакетом. Элементы могут относиться к одному
+// PR: каждый следующий видит замены предыдущих.
+func (uc *BatchReassignReviewersUseCase) Execute(req BatchReassignReviewersRequest) (*BatchResult, error) {
+	prIDs := make([]string, len(req.Items))
+	for i, item := range req.Items {
+		prIDs[i] = item.PRID
+	}
+
+	return runBatch(uc.transactor, req.Mode, prIDs, func(repos interfaces.Repositories, item *BatchItemResult) error {
+		reassign := NewReassignReviewerUseCase(repos.Tx, repos.PRs, repos.Users, repos.Teams, repos.History, uc.reviewer, uc.clock)
+		response, err := reassign.Execute(req.Items[item.Index])
+		if err != nil {
+			return err
+		}
+		item.PR = response.PR
+		item.ReplacedBy = response.ReplacedBy
+		return nil
+	})
+}
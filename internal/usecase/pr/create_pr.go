@@ -6,30 +6,42 @@ import (
 )
 
 type CreatePRUseCase struct {
-	prRepo   interfaces.PRRepository
-	userRepo interfaces.UserRepository
-	teamRepo interfaces.TeamRepository
-	reviewer *domain.ReviewerAssigner
+	transactor interfaces.Transactor
+	prRepo     interfaces.PRRepository
+	planner    *assignmentPlanner
+	clock      domain.Clock
 }
 
 func NewCreatePRUseCase(
+	transactor interfaces.Transactor,
 	prRepo interfaces.PRRepository,
 	userRepo interfaces.UserRepository,
 	teamRepo interfaces.TeamRepository,
+	ownershipRepo interfaces.OwnershipRepository,
+	historyRepo interfaces.AssignmentHistoryRepository,
 	reviewer *domain.ReviewerAssigner,
+	clock domain.Clock,
 ) *CreatePRUseCase {
 	return &CreatePRUseCase{
-		prRepo:   prRepo,
-		userRepo: userRepo,
-		teamRepo: teamRepo,
-		reviewer: reviewer,
+		transactor: transactor,
+		prRepo:     prRepo,
+		planner: &assignmentPlanner{
+			userRepo:      userRepo,
+			teamRepo:      teamRepo,
+			ownershipRepo: ownershipRepo,
+			historyRepo:   historyRepo,
+			reviewer:      reviewer,
+		},
+		clock: clock,
 	}
 }
 
 type CreatePRRequest struct {
-	PRID     string
-	PRName   string
-	AuthorID string
+	PRID         string
+	PRName       string
+	AuthorID     string
+	ChangedFiles []string
+	Labels       []string
 }
 
 func (uc *CreatePRUseCase) Execute(req CreatePRRequest) (*domain.PullRequest, error) {
@@ -38,30 +50,42 @@ func (uc *CreatePRUseCase) Execute(req CreatePRRequest) (*domain.PullRequest, er
 		return nil, err
 	}
 	if exists {
-		return nil, domain.ErrPRExists
+		return nil, domain.ErrPRExists.WithDetails(domain.Details{"pull_request_id": req.PRID})
 	}
 
-	author, err := uc.userRepo.GetByID(req.AuthorID)
-	if err != nil {
-		return nil, err
-	}
-	if author == nil {
-		return nil, domain.ErrNotFound
-	}
+	labels := domain.NormalizeTags(req.Labels)
 
-	team, err := uc.teamRepo.GetByName(author.TeamName)
+	assignment, err := uc.planner.plan(req.AuthorID, req.ChangedFiles, labels)
 	if err != nil {
 		return nil, err
 	}
-	if team == nil {
-		return nil, domain.ErrNotFound
+
+	now := uc.clock.Now()
+	pr := domain.NewPullRequest(req.PRID, req.PRName, req.AuthorID, assignment.ReviewerIDs, now)
+	pr.Labels = labels
+	for reviewerID, teamName := range assignment.FallbackTeams {
+		pr.SetFallbackTeam(reviewerID, teamName)
 	}
 
-	reviewers := uc.reviewer.AssignReviewers(team, author, 2)
+	// PR сохраняется вместе с историей и обоснованием: без них назначение
+	// нельзя объяснить
+	err = uc.transactor.WithinTx(func(repos interfaces.Repositories) error {
+		if err := repos.PRs.Create(pr); err != nil {
+			return err
+		}
 
-	pr := domain.NewPullRequest(req.PRID, req.PRName, req.AuthorID, reviewers)
+		records := make([]*domain.AssignmentRecord, 0, len(assignment.ReviewerIDs))
+		for _, reviewerID := range assignment.ReviewerIDs {
+			records = append(records, domain.NewAssignmentRecord(pr.ID, domain.ActionAssigned, reviewerID, assignment.Seed, now))
+		}
+		if err := repos.History.Create(records); err != nil {
+			return err
+		}
 
-	if err := uc.prRepo.Create(pr); err != nil {
+		reasoning := domain.NewAssignmentReasoning(pr.ID, domain.ActionAssigned, assignment.Seed, assignment.Decisions, now)
+		return repos.History.CreateReasoning(reasoning)
+	})
+	if err != nil {
 		return nil, err
 	}
 
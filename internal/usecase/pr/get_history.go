@@ -0,0 +1,34 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetHistoryUseCase struct {
+	prRepo      interfaces.PRRepository
+	historyRepo interfaces.AssignmentHistoryRepository
+}
+
+func NewGetHistoryUseCase(prRepo interfaces.PRRepository, historyRepo interfaces.AssignmentHistoryRepository) *GetHistoryUseCase {
+	return &GetHistoryUseCase{
+		prRepo:      prRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+type GetHistoryRequest struct {
+	PRID string
+}
+
+func (uc *GetHistoryUseCase) Execute(req GetHistoryRequest) ([]*domain.AssignmentRecord, error) {
+	exists, err := uc.prRepo.Exists(req.PRID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.NotFound(domain.EntityPullRequest, req.PRID)
+	}
+
+	return uc.historyRepo.GetByPRID(req.PRID)
+}
@@ -0,0 +1,29 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetPRUseCase struct {
+	prRepo interfaces.PRRepository
+}
+
+func NewGetPRUseCase(prRepo interfaces.PRRepository) *GetPRUseCase {
+	return &GetPRUseCase{
+		prRepo: prRepo,
+	}
+}
+
+func (uc *GetPRUseCase) Execute(prID string) (*domain.PullRequest, error) {
+	pr, err := uc.prRepo.GetByID(prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr == nil {
+		return nil, domain.NotFound(domain.EntityPullRequest, prID)
+	}
+
+	return pr, nil
+}
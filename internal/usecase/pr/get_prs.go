@@ -0,0 +1,32 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// GetPRsUseCase загружает несколько PR одним обращением к репозиторию.
+// Отсутствующих PR в результате нет.
+type GetPRsUseCase struct {
+	prRepo interfaces.PRRepository
+}
+
+func NewGetPRsUseCase(prRepo interfaces.PRRepository) *GetPRsUseCase {
+	return &GetPRsUseCase{
+		prRepo: prRepo,
+	}
+}
+
+func (uc *GetPRsUseCase) Execute(prIDs []string) (map[string]*domain.PullRequest, error) {
+	prs, err := uc.prRepo.GetByIDs(prIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*domain.PullRequest, len(prs))
+	for _, pr := range prs {
+		byID[pr.ID] = pr
+	}
+
+	return byID, nil
+}
@@ -0,0 +1,34 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetReasoningUseCase struct {
+	prRepo      interfaces.PRRepository
+	historyRepo interfaces.AssignmentHistoryRepository
+}
+
+func NewGetReasoningUseCase(prRepo interfaces.PRRepository, historyRepo interfaces.AssignmentHistoryRepository) *GetReasoningUseCase {
+	return &GetReasoningUseCase{
+		prRepo:      prRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+type GetReasoningRequest struct {
+	PRID string
+}
+
+func (uc *GetReasoningUseCase) Execute(req GetReasoningRequest) ([]*domain.AssignmentReasoning, error) {
+	exists, err := uc.prRepo.Exists(req.PRID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.NotFound(domain.EntityPullRequest, req.PRID)
+	}
+
+	return uc.historyRepo.GetReasoningByPRID(req.PRID)
+}
@@ -0,0 +1,92 @@
+package pr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+type ListPRsUseCase struct {
+	prRepo interfaces.PRRepository
+}
+
+func NewListPRsUseCase(prRepo interfaces.PRRepository) *ListPRsUseCase {
+	return &ListPRsUseCase{
+		prRepo: prRepo,
+	}
+}
+
+// ListPRsRequest — фильтры списка. Интервал создания — [CreatedFrom,
+// CreatedTo); Limit 0 означает DefaultPageLimit.
+type ListPRsRequest struct {
+	AuthorID    string
+	TeamName    string
+	ReviewerID  string
+	Status      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Limit       int
+	Offset      int
+}
+
+// ListPRsResponse — страница списка и общее число PR под фильтром.
+type ListPRsResponse struct {
+	PullRequests []*domain.PullRequest
+	Total        int
+	Limit        int
+	Offset       int
+}
+
+// Execute возвращает PR под фильтром от новых к старым.
+func (uc *ListPRsUseCase) Execute(req ListPRsRequest) (*ListPRsResponse, error) {
+	filter := domain.PRFilter{
+		AuthorID:    req.AuthorID,
+		TeamName:    req.TeamName,
+		ReviewerID:  req.ReviewerID,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+	}
+
+	if req.Status != "" {
+		status, err := domain.ParsePRStatus(req.Status)
+		if err != nil {
+			return nil, err
+		}
+		filter.Status = status
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = DefaultPageLimit
+	}
+	if filter.Limit < 0 || filter.Limit > MaxPageLimit {
+		return nil, domain.InvalidArgument("limit", fmt.Sprintf("must be between 0 and %d", MaxPageLimit))
+	}
+	if filter.Offset < 0 {
+		return nil, domain.InvalidArgument("offset", "must not be negative")
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedTo.Before(*filter.CreatedFrom) {
+		return nil, domain.InvalidArgument("created_to", "must not be before created_from")
+	}
+
+	prs, total, err := uc.prRepo.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListPRsResponse{
+		PullRequests: prs,
+		Total:        total,
+		Limit:        filter.Limit,
+		Offset:       filter.Offset,
+	}, nil
+}
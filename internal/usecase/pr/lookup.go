@@ -0,0 +1,72 @@
+package pr
+
+import (
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// loadAuthorTeam возвращает команду автора PR: её правила старшинства и пар
+// определяют требования к составу ревьюеров.
+func loadAuthorTeam(userRepo interfaces.UserRepository, teamRepo interfaces.TeamRepository, authorID string) (*domain.Team, error) {
+	author, err := userRepo.GetByID(authorID)
+	if err != nil {
+		return nil, err
+	}
+	if author == nil {
+		return nil, domain.NotFound(domain.EntityUser, authorID)
+	}
+
+	team, err := teamRepo.GetByName(author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.NotFound(domain.EntityTeam, author.TeamName)
+	}
+
+	return team, nil
+}
+
+// loadFallbackTeams загружает запасные команды team в порядке обращения,
+// пропуская удалённые.
+func loadFallbackTeams(teamRepo interfaces.TeamRepository, team *domain.Team) ([]*domain.Team, error) {
+	fallbackTeams := make([]*domain.Team, 0, len(team.FallbackTeams))
+	for _, teamName := range team.FallbackTeams {
+		fallbackTeam, err := teamRepo.GetByName(teamName)
+		if err != nil {
+			return nil, err
+		}
+		if fallbackTeam != nil {
+			fallbackTeams = append(fallbackTeams, fallbackTeam)
+		}
+	}
+
+	return fallbackTeams, nil
+}
+
+// loadUsers загружает пользователей по ID, пропуская удалённых.
+func loadUsers(userRepo interfaces.UserRepository, userIDs []string) ([]*domain.User, error) {
+	users := make([]*domain.User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		user, err := userRepo.GetByID(userID)
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			users = append(users, user)
+		}
+	}
+
+	return users, nil
+}
+
+// requestedReasoning объясняет ручное назначение: выбор сделал пользователь,
+// поэтому ранжирования и seed нет.
+func requestedReasoning(prID string, action domain.AssignmentAction, reviewerID string, at time.Time) *domain.AssignmentReasoning {
+	decisions := []domain.CandidateDecision{
+		{UserID: reviewerID, Status: domain.CandidateChosen, Reason: domain.ReasonRequested},
+	}
+	return domain.NewAssignmentReasoning(prID, action, 0, decisions, at)
+}
@@ -7,16 +7,19 @@ import (
 
 type MergePRUseCase struct {
 	prRepo interfaces.PRRepository
+	clock  domain.Clock
 }
 
-func NewMergePRUseCase(prRepo interfaces.PRRepository) *MergePRUseCase {
+func NewMergePRUseCase(prRepo interfaces.PRRepository, clock domain.Clock) *MergePRUseCase {
 	return &MergePRUseCase{
 		prRepo: prRepo,
+		clock:  clock,
 	}
 }
 
 type MergePRRequest struct {
-	PRID string
+	PRID    string
+	IfMatch *int64
 }
 
 func (uc *MergePRUseCase) Execute(req MergePRRequest) (*domain.PullRequest, error) {
@@ -25,14 +28,19 @@ func (uc *MergePRUseCase) Execute(req MergePRRequest) (*domain.PullRequest, erro
 		return nil, err
 	}
 	if pr == nil {
-		return nil, domain.ErrNotFound
+		return nil, domain.NotFound(domain.EntityPullRequest, req.PRID)
 	}
 
-	if err := pr.Merge(); err != nil {
+	if err := domain.CheckVersion(pr.Version, req.IfMatch); err != nil {
 		return nil, err
 	}
 
-	if err := uc.prRepo.Update(pr); err != nil {
+	now := uc.clock.Now()
+	if err := pr.Merge(now); err != nil {
+		return nil, err
+	}
+
+	if err := uc.prRepo.Update(pr, now); err != nil {
 		return nil, err
 	}
 
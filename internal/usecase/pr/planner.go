@@ -0,0 +1,101 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// assignmentPlanner подбирает ревьюеров для нового PR. Используется и при
+// создании PR, и при предпросмотре, чтобы предпросмотр не расходился с
+// реальным назначением.
+type assignmentPlanner struct {
+	userRepo      interfaces.UserRepository
+	teamRepo      interfaces.TeamRepository
+	ownershipRepo interfaces.OwnershipRepository
+	historyRepo   interfaces.AssignmentHistoryRepository
+	reviewer      *domain.ReviewerAssigner
+}
+
+func (p *assignmentPlanner) plan(authorID string, changedFiles, labels []string) (*domain.Assignment, error) {
+	author, err := p.userRepo.GetByID(authorID)
+	if err != nil {
+		return nil, err
+	}
+	if author == nil {
+		return nil, domain.NotFound(domain.EntityUser, authorID)
+	}
+
+	team, err := p.teamRepo.GetByName(author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.NotFound(domain.EntityTeam, author.TeamName)
+	}
+
+	ownerGroups, err := p.getOwnerGroups(changedFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	recent, err := recentReviewerIDs(p.historyRepo, p.reviewer, team.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	fallbackTeams, err := loadFallbackTeams(p.teamRepo, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.reviewer.AssignReviewers(domain.AssignmentRequest{
+		Team:              team,
+		Author:            author,
+		MaxReviewers:      team.TargetReviewers(),
+		OwnerGroups:       ownerGroups,
+		Labels:            labels,
+		RecentReviewerIDs: recent,
+		FallbackTeams:     fallbackTeams,
+	})
+}
+
+func (p *assignmentPlanner) getOwnerGroups(changedFiles []string) ([][]*domain.User, error) {
+	if len(changedFiles) == 0 {
+		return nil, nil
+	}
+
+	rules, err := p.ownershipRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	matches := domain.MatchOwnership(rules, changedFiles)
+	groups := make([][]*domain.User, 0, len(matches))
+	for _, match := range matches {
+		var owners []*domain.User
+
+		if match.Rule.TeamName != "" {
+			ownerTeam, err := p.teamRepo.GetByName(match.Rule.TeamName)
+			if err != nil {
+				return nil, err
+			}
+			if ownerTeam != nil {
+				owners = append(owners, ownerTeam.Members...)
+			}
+		}
+
+		for _, userID := range match.Rule.UserIDs {
+			owner, err := p.userRepo.GetByID(userID)
+			if err != nil {
+				return nil, err
+			}
+			if owner != nil {
+				owners = append(owners, owner)
+			}
+		}
+
+		groups = append(groups, owners)
+	}
+
+	return groups, nil
+}
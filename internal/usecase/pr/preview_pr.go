@@ -0,0 +1,40 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// PreviewPRUseCase выполняет подбор ревьюеров так же, как создание PR, но
+// ничего не сохраняет.
+type PreviewPRUseCase struct {
+	planner *assignmentPlanner
+}
+
+func NewPreviewPRUseCase(
+	userRepo interfaces.UserRepository,
+	teamRepo interfaces.TeamRepository,
+	ownershipRepo interfaces.OwnershipRepository,
+	historyRepo interfaces.AssignmentHistoryRepository,
+	reviewer *domain.ReviewerAssigner,
+) *PreviewPRUseCase {
+	return &PreviewPRUseCase{
+		planner: &assignmentPlanner{
+			userRepo:      userRepo,
+			teamRepo:      teamRepo,
+			ownershipRepo: ownershipRepo,
+			historyRepo:   historyRepo,
+			reviewer:      reviewer,
+		},
+	}
+}
+
+type PreviewPRRequest struct {
+	AuthorID     string
+	ChangedFiles []string
+	Labels       []string
+}
+
+func (uc *PreviewPRUseCase) Execute(req PreviewPRRequest) (*domain.Assignment, error) {
+	return uc.planner.plan(req.AuthorID, req.ChangedFiles, domain.NormalizeTags(req.Labels))
+}
@@ -1,34 +1,50 @@
 package pr
 
 import (
+	"errors"
+	"time"
+
 	"github.com/avito-tech-backend-autumn-2025/internal/domain"
 	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
 )
 
 type ReassignReviewerUseCase struct {
-	prRepo   interfaces.PRRepository
-	userRepo interfaces.UserRepository
-	teamRepo interfaces.TeamRepository
-	reviewer *domain.ReviewerAssigner
+	transactor  interfaces.Transactor
+	prRepo      interfaces.PRRepository
+	userRepo    interfaces.UserRepository
+	teamRepo    interfaces.TeamRepository
+	historyRepo interfaces.AssignmentHistoryRepository
+	reviewer    *domain.ReviewerAssigner
+	clock       domain.Clock
 }
 
 func NewReassignReviewerUseCase(
+	transactor interfaces.Transactor,
 	prRepo interfaces.PRRepository,
 	userRepo interfaces.UserRepository,
 	teamRepo interfaces.TeamRepository,
+	historyRepo interfaces.AssignmentHistoryRepository,
 	reviewer *domain.ReviewerAssigner,
+	clock domain.Clock,
 ) *ReassignReviewerUseCase {
 	return &ReassignReviewerUseCase{
-		prRepo:   prRepo,
-		userRepo: userRepo,
-		teamRepo: teamRepo,
-		reviewer: reviewer,
+		transactor:  transactor,
+		prRepo:      prRepo,
+		userRepo:    userRepo,
+		teamRepo:    teamRepo,
+		historyRepo: historyRepo,
+		reviewer:    reviewer,
+		clock:       clock,
 	}
 }
 
+// ReassignReviewerRequest — если NewUserID задан, замена назначается на этого
+// пользователя, иначе выбирается автоматически.
 type ReassignReviewerRequest struct {
 	PRID      string
 	OldUserID string
+	NewUserID string
+	IfMatch   *int64
 }
 
 type ReassignReviewerResponse struct {
@@ -42,15 +58,19 @@ func (uc *ReassignReviewerUseCase) Execute(req ReassignReviewerRequest) (*Reassi
 		return nil, err
 	}
 	if pr == nil {
-		return nil, domain.ErrNotFound
+		return nil, domain.NotFound(domain.EntityPullRequest, req.PRID)
+	}
+
+	if err := domain.CheckVersion(pr.Version, req.IfMatch); err != nil {
+		return nil, err
 	}
 
 	if !pr.CanReassign() {
-		return nil, domain.ErrPRMerged
+		return nil, pr.MergedError()
 	}
 
 	if !pr.HasReviewer(req.OldUserID) {
-		return nil, domain.ErrNotAssigned
+		return nil, pr.ReviewerError(domain.ErrNotAssigned, req.OldUserID)
 	}
 
 	oldReviewer, err := uc.userRepo.GetByID(req.OldUserID)
@@ -58,7 +78,7 @@ func (uc *ReassignReviewerUseCase) Execute(req ReassignReviewerRequest) (*Reassi
 		return nil, err
 	}
 	if oldReviewer == nil {
-		return nil, domain.ErrNotFound
+		return nil, domain.NotFound(domain.EntityUser, req.OldUserID)
 	}
 
 	team, err := uc.teamRepo.GetByName(oldReviewer.TeamName)
@@ -66,27 +86,125 @@ func (uc *ReassignReviewerUseCase) Execute(req ReassignReviewerRequest) (*Reassi
 		return nil, err
 	}
 	if team == nil {
-		return nil, domain.ErrNotFound
+		return nil, domain.NotFound(domain.EntityTeam, oldReviewer.TeamName)
 	}
 
-	excludeIDs := []string{pr.AuthorID}
-	excludeIDs = append(excludeIDs, pr.AssignedReviewers...)
+	authorTeam, err := uc.getAuthorTeam(pr.AuthorID, team)
+	if err != nil {
+		return nil, err
+	}
 
-	newReviewer, err := uc.reviewer.FindReplacementCandidate(team, excludeIDs)
+	reviewers, err := loadUsers(uc.userRepo, pr.AssignedReviewers)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := pr.ReplaceReviewer(req.OldUserID, newReviewer.UserID); err != nil {
+	now := uc.clock.Now()
+
+	newReviewerID := req.NewUserID
+	fallbackTeam := ""
+	var reasoning *domain.AssignmentReasoning
+	if newReviewerID != "" {
+		if err := uc.checkRequestedReviewer(pr, newReviewerID, team, authorTeam, oldReviewer, reviewers, now); err != nil {
+			return nil, err
+		}
+		reasoning = requestedReasoning(pr.ID, domain.ActionReassigned, newReviewerID, now)
+	} else {
+		replacement, err := uc.findReplacement(pr, team, authorTeam, oldReviewer, reviewers)
+		if err != nil {
+			return nil, err
+		}
+		newReviewerID = replacement.Reviewer.UserID
+		fallbackTeam = replacement.FallbackTeam
+		reasoning = domain.NewAssignmentReasoning(pr.ID, domain.ActionReassigned, replacement.Seed, replacement.Decisions, now)
+	}
+
+	if err := pr.ReplaceReviewer(req.OldUserID, newReviewerID); err != nil {
 		return nil, err
 	}
+	pr.SetFallbackTeam(newReviewerID, fallbackTeam)
 
-	if err := uc.prRepo.Update(pr); err != nil {
+	record := domain.NewAssignmentRecord(pr.ID, domain.ActionReassigned, newReviewerID, reasoning.Seed, now)
+	record.ReplacedReviewerID = req.OldUserID
+	if err := saveReviewers(uc.transactor, pr, now, []*domain.AssignmentRecord{record}, []*domain.AssignmentReasoning{reasoning}); err != nil {
 		return nil, err
 	}
 
 	return &ReassignReviewerResponse{
 		PR:         pr,
-		ReplacedBy: newReviewer.UserID,
+		ReplacedBy: newReviewerID,
 	}, nil
 }
+
+func (uc *ReassignReviewerUseCase) findReplacement(pr *domain.PullRequest, team, authorTeam *domain.Team, oldReviewer *domain.User, reviewers []*domain.User) (*domain.Replacement, error) {
+	excludeIDs := []string{pr.AuthorID}
+	excludeIDs = append(excludeIDs, pr.AssignedReviewers...)
+
+	recent, err := recentReviewerIDs(uc.historyRepo, uc.reviewer, team.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	fallbackTeams, err := loadFallbackTeams(uc.teamRepo, team)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement, err := uc.reviewer.FindReplacementCandidate(domain.ReplacementRequest{
+		Team:              team,
+		AuthorID:          pr.AuthorID,
+		OldReviewer:       oldReviewer,
+		Reviewers:         reviewers,
+		ExcludeUserIDs:    excludeIDs,
+		Labels:            pr.Labels,
+		SeniorityRule:     authorTeam.SeniorityRule,
+		PairingRules:      authorTeam.PairingRules,
+		RecentReviewerIDs: recent,
+		FallbackTeams:     fallbackTeams,
+	})
+	if errors.Is(err, domain.ErrNoCandidate) {
+		return nil, pr.ReviewerError(domain.ErrNoCandidate, oldReviewer.UserID)
+	}
+	return replacement, err
+}
+
+// checkRequestedReviewer проверяет выбранную вручную замену: она из команды
+// уходящего ревьюера и не нарушает правила команды автора.
+func (uc *ReassignReviewerUseCase) checkRequestedReviewer(pr *domain.PullRequest, newUserID string, team, authorTeam *domain.Team, oldReviewer *domain.User, reviewers []*domain.User, now time.Time) error {
+	newReviewer, err := uc.userRepo.GetByID(newUserID)
+	if err != nil {
+		return err
+	}
+	if newReviewer == nil {
+		return domain.NotFound(domain.EntityUser, newUserID)
+	}
+
+	if err := pr.CheckReviewer(newReviewer, team.TeamName, authorTeam.PairingRules, now); err != nil {
+		return err
+	}
+
+	rule := authorTeam.SeniorityRule
+	if rule != nil && rule.RequiresReplacement(reviewers, oldReviewer) && !rule.IsSatisfiedBy(newReviewer) {
+		return rule.Violation()
+	}
+
+	return nil
+}
+
+// getAuthorTeam возвращает команду автора PR, не загружая её повторно, если
+// автор из команды ревьюера.
+func (uc *ReassignReviewerUseCase) getAuthorTeam(authorID string, reviewerTeam *domain.Team) (*domain.Team, error) {
+	author, err := uc.userRepo.GetByID(authorID)
+	if err != nil {
+		return nil, err
+	}
+	if author == nil {
+		return nil, domain.NotFound(domain.EntityUser, authorID)
+	}
+
+	if author.TeamName == reviewerTeam.TeamName {
+		return reviewerTeam, nil
+	}
+
+	return loadAuthorTeam(uc.userRepo, uc.teamRepo, authorID)
+}
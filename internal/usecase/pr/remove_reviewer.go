@@ -0,0 +1,89 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// RemoveReviewerUseCase снимает ревьюера с PR без замены.
+type RemoveReviewerUseCase struct {
+	transactor interfaces.Transactor
+	prRepo     interfaces.PRRepository
+	userRepo   interfaces.UserRepository
+	teamRepo   interfaces.TeamRepository
+	clock      domain.Clock
+}
+
+func NewRemoveReviewerUseCase(
+	transactor interfaces.Transactor,
+	prRepo interfaces.PRRepository,
+	userRepo interfaces.UserRepository,
+	teamRepo interfaces.TeamRepository,
+	clock domain.Clock,
+) *RemoveReviewerUseCase {
+	return &RemoveReviewerUseCase{
+		transactor: transactor,
+		prRepo:     prRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		clock:      clock,
+	}
+}
+
+type RemoveReviewerRequest struct {
+	PRID    string
+	UserID  string
+	IfMatch *int64
+}
+
+func (uc *RemoveReviewerUseCase) Execute(req RemoveReviewerRequest) (*domain.PullRequest, error) {
+	pr, err := uc.prRepo.GetByID(req.PRID)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, domain.NotFound(domain.EntityPullRequest, req.PRID)
+	}
+
+	if err := domain.CheckVersion(pr.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	if !pr.CanReassign() {
+		return nil, pr.MergedError()
+	}
+
+	if !pr.HasReviewer(req.UserID) {
+		return nil, pr.ReviewerError(domain.ErrNotAssigned, req.UserID)
+	}
+
+	authorTeam, err := loadAuthorTeam(uc.userRepo, uc.teamRepo, pr.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule := authorTeam.SeniorityRule; rule != nil {
+		reviewers, err := loadUsers(uc.userRepo, pr.AssignedReviewers)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reviewer := range reviewers {
+			if reviewer.UserID == req.UserID && rule.RequiresReplacement(reviewers, reviewer) {
+				return nil, rule.Violation()
+			}
+		}
+	}
+
+	if err := pr.RemoveReviewer(req.UserID); err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	record := domain.NewAssignmentRecord(pr.ID, domain.ActionRemoved, req.UserID, 0, now)
+	if err := saveReviewers(uc.transactor, pr, now, []*domain.AssignmentRecord{record}, nil); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
@@ -0,0 +1,16 @@
+package pr
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// recentReviewerIDs загружает окно последних назначений команды для ротации.
+// Если ротация отключена, история не читается.
+func recentReviewerIDs(historyRepo interfaces.AssignmentHistoryRepository, reviewer *domain.ReviewerAssigner, teamName string) ([]string, error) {
+	window := reviewer.FairnessWindow()
+	if window == 0 {
+		return nil, nil
+	}
+	return historyRepo.GetRecentReviewerIDs(teamName, window)
+}
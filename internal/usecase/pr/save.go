@@ -0,0 +1,31 @@
+package pr
+
+import (
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// saveReviewers сохраняет изменённый состав ревьюеров PR вместе с записями
+// истории и обоснованиями одной транзакцией: изменение не остаётся без следа в
+// истории, а история — без изменения. Новые ревьюеры назначены в at.
+func saveReviewers(transactor interfaces.Transactor, pr *domain.PullRequest, at time.Time, records []*domain.AssignmentRecord, reasonings []*domain.AssignmentReasoning) error {
+	return transactor.WithinTx(func(repos interfaces.Repositories) error {
+		if err := repos.PRs.Update(pr, at); err != nil {
+			return err
+		}
+
+		if err := repos.History.Create(records); err != nil {
+			return err
+		}
+
+		for _, reasoning := range reasonings {
+			if err := repos.History.CreateReasoning(reasoning); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
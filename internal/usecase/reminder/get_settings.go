@@ -0,0 +1,38 @@
+package reminder
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetSettingsUseCase struct {
+	settingsRepo interfaces.NotificationSettingsRepository
+	userRepo     interfaces.UserRepository
+}
+
+func NewGetSettingsUseCase(settingsRepo interfaces.NotificationSettingsRepository, userRepo interfaces.UserRepository) *GetSettingsUseCase {
+	return &GetSettingsUseCase{
+		settingsRepo: settingsRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (uc *GetSettingsUseCase) Execute(userID string) (*domain.NotificationSettings, error) {
+	exists, err := uc.userRepo.Exists(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.NotFound(domain.EntityUser, userID)
+	}
+
+	settings, err := uc.settingsRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = domain.NewNotificationSettings(userID)
+	}
+
+	return settings, nil
+}
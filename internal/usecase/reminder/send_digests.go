@@ -0,0 +1,123 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// Notifier доставляет дайджест пользователю: по почте, в чат и т.д.
+type Notifier interface {
+	Send(ctx context.Context, digest *domain.ReviewDigest) error
+}
+
+// SendDigestsUseCase рассылает ревьюерам дайджесты их открытых PR с учётом
+// выбранной частоты. Запускается фоновой задачей.
+type SendDigestsUseCase struct {
+	prRepo       interfaces.PRRepository
+	userRepo     interfaces.UserRepository
+	settingsRepo interfaces.NotificationSettingsRepository
+	notifier     Notifier
+	clock        domain.Clock
+}
+
+func NewSendDigestsUseCase(
+	prRepo interfaces.PRRepository,
+	userRepo interfaces.UserRepository,
+	settingsRepo interfaces.NotificationSettingsRepository,
+	notifier Notifier,
+	clock domain.Clock,
+) *SendDigestsUseCase {
+	return &SendDigestsUseCase{
+		prRepo:       prRepo,
+		userRepo:     userRepo,
+		settingsRepo: settingsRepo,
+		notifier:     notifier,
+		clock:        clock,
+	}
+}
+
+type DigestResult struct {
+	UserID  string
+	PRCount int
+	Err     error
+}
+
+func (uc *SendDigestsUseCase) Execute(ctx context.Context) ([]DigestResult, error) {
+	now := uc.clock.Now()
+
+	assignments, err := uc.prRepo.GetOpenAssignments()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var results []DigestResult
+	for _, assignment := range assignments {
+		if seen[assignment.ReviewerID] {
+			continue
+		}
+		seen[assignment.ReviewerID] = true
+
+		settings, err := uc.settingsRepo.GetByUserID(assignment.ReviewerID)
+		if err != nil {
+			return results, err
+		}
+		if settings == nil {
+			settings = domain.NewNotificationSettings(assignment.ReviewerID)
+		}
+		if !settings.IsDue(now) {
+			continue
+		}
+
+		digest, err := uc.buildDigest(settings, now)
+		if err != nil {
+			return results, err
+		}
+		if digest == nil {
+			continue
+		}
+
+		result := DigestResult{UserID: settings.UserID, PRCount: len(digest.PullRequests)}
+		if err := uc.notifier.Send(ctx, digest); err != nil {
+			result.Err = err
+		} else if err := uc.settingsRepo.MarkSent(settings.UserID, now); err != nil {
+			return results, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (uc *SendDigestsUseCase) buildDigest(settings *domain.NotificationSettings, now time.Time) (*domain.ReviewDigest, error) {
+	user, err := uc.userRepo.GetByID(settings.UserID)
+	if err != nil || user == nil {
+		return nil, err
+	}
+
+	prs, err := uc.prRepo.GetByReviewerID(settings.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var open []*domain.PullRequest
+	for _, pullRequest := range prs {
+		if pullRequest.Status == domain.StatusOpen {
+			open = append(open, pullRequest)
+		}
+	}
+	if len(open) == 0 {
+		return nil, nil
+	}
+
+	return &domain.ReviewDigest{
+		User:         user,
+		Email:        settings.Email,
+		PullRequests: open,
+		GeneratedAt:  now,
+	}, nil
+}
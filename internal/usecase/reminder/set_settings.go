@@ -0,0 +1,69 @@
+package reminder
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetSettingsUseCase struct {
+	settingsRepo interfaces.NotificationSettingsRepository
+	userRepo     interfaces.UserRepository
+}
+
+func NewSetSettingsUseCase(settingsRepo interfaces.NotificationSettingsRepository, userRepo interfaces.UserRepository) *SetSettingsUseCase {
+	return &SetSettingsUseCase{
+		settingsRepo: settingsRepo,
+		userRepo:     userRepo,
+	}
+}
+
+type SetSettingsRequest struct {
+	UserID    string
+	Email     string
+	Frequency string
+}
+
+// Execute сохраняет адрес и частоту дайджестов. Frequency = OFF отключает напоминания.
+func (uc *SetSettingsUseCase) Execute(req SetSettingsRequest) (*domain.NotificationSettings, error) {
+	frequency, err := domain.ParseReminderFrequency(req.Frequency)
+	if err != nil {
+		return nil, err
+	}
+
+	var email string
+	if strings.TrimSpace(req.Email) != "" {
+		address, err := mail.ParseAddress(req.Email)
+		if err != nil {
+			return nil, domain.InvalidArgument("email", "must be a valid email address").Wrap(err)
+		}
+		email = address.Address
+	}
+
+	exists, err := uc.userRepo.Exists(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.NotFound(domain.EntityUser, req.UserID)
+	}
+
+	settings, err := uc.settingsRepo.GetByUserID(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = domain.NewNotificationSettings(req.UserID)
+	}
+
+	settings.Email = email
+	settings.Frequency = frequency
+
+	if err := uc.settingsRepo.Save(settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
@@ -23,9 +23,10 @@ type CreateTeamRequest struct {
 }
 
 type TeamMemberRequest struct {
-	UserID   string
-	Username string
-	IsActive bool
+	UserID    string
+	Username  string
+	IsActive  bool
+	Seniority string
 }
 
 func (uc *CreateTeamUseCase) Execute(req CreateTeamRequest) (*domain.Team, error) {
@@ -34,7 +35,15 @@ func (uc *CreateTeamUseCase) Execute(req CreateTeamRequest) (*domain.Team, error
 		return nil, err
 	}
 	if exists {
-		return nil, domain.ErrTeamExists
+		return nil, domain.ErrTeamExists.WithDetails(domain.Details{"team_name": req.TeamName})
+	}
+
+	for _, memberReq := range req.Members {
+		if memberReq.Seniority != "" {
+			if _, err := domain.ParseSeniority(memberReq.Seniority); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	team := domain.NewTeam(req.TeamName, nil)
@@ -50,6 +59,9 @@ func (uc *CreateTeamUseCase) Execute(req CreateTeamRequest) (*domain.Team, error
 		}
 
 		user := domain.NewUser(memberReq.UserID, memberReq.Username, req.TeamName, memberReq.IsActive)
+		if memberReq.Seniority != "" {
+			user.Seniority = domain.Seniority(memberReq.Seniority)
+		}
 
 		if userExists {
 			user, err = uc.userRepo.GetByID(memberReq.UserID)
@@ -58,6 +70,9 @@ func (uc *CreateTeamUseCase) Execute(req CreateTeamRequest) (*domain.Team, error
 			}
 			user.TeamName = req.TeamName
 			user.IsActive = memberReq.IsActive
+			if memberReq.Seniority != "" {
+				user.Seniority = domain.Seniority(memberReq.Seniority)
+			}
 			if err := uc.userRepo.Update(user); err != nil {
 				return nil, err
 			}
@@ -0,0 +1,56 @@
+package team
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// ExplainPairingUseCase показывает, как правила пар команды автора влияют на
+// каждого её участника. Ничего не сохраняет.
+type ExplainPairingUseCase struct {
+	teamRepo interfaces.TeamRepository
+	userRepo interfaces.UserRepository
+	clock    domain.Clock
+}
+
+func NewExplainPairingUseCase(teamRepo interfaces.TeamRepository, userRepo interfaces.UserRepository, clock domain.Clock) *ExplainPairingUseCase {
+	return &ExplainPairingUseCase{
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+		clock:    clock,
+	}
+}
+
+type ExplainPairingRequest struct {
+	AuthorID string
+}
+
+type ExplainPairingResponse struct {
+	TeamName  string
+	AuthorID  string
+	Decisions []domain.PairingDecision
+}
+
+func (uc *ExplainPairingUseCase) Execute(req ExplainPairingRequest) (*ExplainPairingResponse, error) {
+	author, err := uc.userRepo.GetByID(req.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	if author == nil {
+		return nil, domain.NotFound(domain.EntityUser, req.AuthorID)
+	}
+
+	team, err := uc.teamRepo.GetByName(author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.NotFound(domain.EntityTeam, author.TeamName)
+	}
+
+	return &ExplainPairingResponse{
+		TeamName:  team.TeamName,
+		AuthorID:  author.UserID,
+		Decisions: team.ExplainPairing(author.UserID, uc.clock.Now()),
+	}, nil
+}
@@ -22,7 +22,7 @@ func (uc *GetTeamUseCase) Execute(teamName string) (*domain.Team, error) {
 	}
 
 	if team == nil {
-		return nil, domain.ErrNotFound
+		return nil, domain.NotFound(domain.EntityTeam, teamName)
 	}
 
 	return team, nil
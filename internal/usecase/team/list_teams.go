@@ -0,0 +1,22 @@
+package team
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// ListTeamsUseCase возвращает все команды с участниками. Правила команды
+// в список не входят — они есть в GetTeamUseCase.
+type ListTeamsUseCase struct {
+	teamRepo interfaces.TeamRepository
+}
+
+func NewListTeamsUseCase(teamRepo interfaces.TeamRepository) *ListTeamsUseCase {
+	return &ListTeamsUseCase{
+		teamRepo: teamRepo,
+	}
+}
+
+func (uc *ListTeamsUseCase) Execute() ([]*domain.Team, error) {
+	return uc.teamRepo.GetAll()
+}
@@ -0,0 +1,61 @@
+package team
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetFallbackTeamsUseCase struct {
+	teamRepo interfaces.TeamRepository
+}
+
+func NewSetFallbackTeamsUseCase(teamRepo interfaces.TeamRepository) *SetFallbackTeamsUseCase {
+	return &SetFallbackTeamsUseCase{
+		teamRepo: teamRepo,
+	}
+}
+
+type SetFallbackTeamsRequest struct {
+	TeamName      string
+	FallbackTeams []string
+	IfMatch       *int64
+}
+
+// Execute полностью заменяет запасные команды. Команда не может быть запасной
+// сама для себя, повторы запрещены; пустой список снимает все запасные команды.
+func (uc *SetFallbackTeamsUseCase) Execute(req SetFallbackTeamsRequest) (*domain.Team, error) {
+	team, err := uc.teamRepo.GetByName(req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.NotFound(domain.EntityTeam, req.TeamName)
+	}
+
+	if err := domain.CheckVersion(team.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, fallbackTeam := range req.FallbackTeams {
+		if fallbackTeam == "" || fallbackTeam == team.TeamName || seen[fallbackTeam] {
+			return nil, domain.InvalidArgument("fallback_teams", "must be distinct, non-empty and differ from team_name")
+		}
+		seen[fallbackTeam] = true
+
+		exists, err := uc.teamRepo.Exists(fallbackTeam)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, domain.NotFound(domain.EntityTeam, fallbackTeam)
+		}
+	}
+
+	if err := uc.teamRepo.SetFallbackTeams(team, req.FallbackTeams); err != nil {
+		return nil, err
+	}
+
+	team.FallbackTeams = req.FallbackTeams
+	return team, nil
+}
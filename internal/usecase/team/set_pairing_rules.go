@@ -0,0 +1,82 @@
+package team
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetPairingRulesUseCase struct {
+	teamRepo interfaces.TeamRepository
+}
+
+func NewSetPairingRulesUseCase(teamRepo interfaces.TeamRepository) *SetPairingRulesUseCase {
+	return &SetPairingRulesUseCase{
+		teamRepo: teamRepo,
+	}
+}
+
+type SetPairingRulesRequest struct {
+	TeamName string
+	Rules    []PairingRuleRequest
+	IfMatch  *int64
+}
+
+type PairingRuleRequest struct {
+	Type       string
+	AuthorID   string
+	ReviewerID string
+}
+
+// Execute полностью заменяет правила пар команды. Оба участника правила должны
+// состоять в команде; пустой список снимает все правила.
+func (uc *SetPairingRulesUseCase) Execute(req SetPairingRulesRequest) (*domain.Team, error) {
+	team, err := uc.teamRepo.GetByName(req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.NotFound(domain.EntityTeam, req.TeamName)
+	}
+
+	if err := domain.CheckVersion(team.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]bool)
+	for _, member := range team.Members {
+		members[member.UserID] = true
+	}
+
+	rules := make(domain.PairingRules, 0, len(req.Rules))
+	for _, ruleReq := range req.Rules {
+		ruleType, err := domain.ParsePairingRuleType(ruleReq.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		rule, err := domain.NewPairingRule(ruleType, ruleReq.AuthorID, ruleReq.ReviewerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !members[rule.ReviewerID] {
+			return nil, domain.NotFound(domain.EntityUser, rule.ReviewerID)
+		}
+		if rule.AuthorID != "" && !members[rule.AuthorID] {
+			return nil, domain.NotFound(domain.EntityUser, rule.AuthorID)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.teamRepo.SetPairingRules(team, rules); err != nil {
+		return nil, err
+	}
+
+	team.PairingRules = rules
+	return team, nil
+}
@@ -0,0 +1,51 @@
+package team
+
+import (
+	"fmt"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetReviewersTargetUseCase struct {
+	teamRepo interfaces.TeamRepository
+}
+
+func NewSetReviewersTargetUseCase(teamRepo interfaces.TeamRepository) *SetReviewersTargetUseCase {
+	return &SetReviewersTargetUseCase{
+		teamRepo: teamRepo,
+	}
+}
+
+type SetReviewersTargetRequest struct {
+	TeamName        string
+	ReviewersTarget int
+	IfMatch         *int64
+}
+
+// Execute задаёт, сколько ревьюеров назначается на PR авторов команды и до
+// скольких их добирает добор. 0 возвращает команду к ReviewersPerPR.
+func (uc *SetReviewersTargetUseCase) Execute(req SetReviewersTargetRequest) (*domain.Team, error) {
+	if req.ReviewersTarget < 0 || req.ReviewersTarget > domain.MaxReviewersTarget {
+		return nil, domain.InvalidArgument("reviewers_target", fmt.Sprintf("must be from 0 to %d", domain.MaxReviewersTarget))
+	}
+
+	team, err := uc.teamRepo.GetByName(req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.NotFound(domain.EntityTeam, req.TeamName)
+	}
+
+	if err := domain.CheckVersion(team.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	if err := uc.teamRepo.SetReviewersTarget(team, req.ReviewersTarget); err != nil {
+		return nil, err
+	}
+
+	team.ReviewersTarget = req.ReviewersTarget
+	return team, nil
+}
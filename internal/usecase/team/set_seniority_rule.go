@@ -0,0 +1,58 @@
+package team
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetSeniorityRuleUseCase struct {
+	teamRepo interfaces.TeamRepository
+}
+
+func NewSetSeniorityRuleUseCase(teamRepo interfaces.TeamRepository) *SetSeniorityRuleUseCase {
+	return &SetSeniorityRuleUseCase{
+		teamRepo: teamRepo,
+	}
+}
+
+type SetSeniorityRuleRequest struct {
+	TeamName     string
+	MinReviewers int
+	MinLevel     string
+	IfMatch      *int64
+}
+
+// Execute устанавливает правило старшинства команды. MinReviewers = 0 снимает правило.
+func (uc *SetSeniorityRuleUseCase) Execute(req SetSeniorityRuleRequest) (*domain.Team, error) {
+	if req.MinReviewers < 0 {
+		return nil, domain.InvalidArgument("min_reviewers", "must not be negative")
+	}
+
+	team, err := uc.teamRepo.GetByName(req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.NotFound(domain.EntityTeam, req.TeamName)
+	}
+
+	if err := domain.CheckVersion(team.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	var rule *domain.SeniorityRule
+	if req.MinReviewers > 0 {
+		minLevel, err := domain.ParseSeniority(req.MinLevel)
+		if err != nil {
+			return nil, err
+		}
+		rule = domain.NewSeniorityRule(req.MinReviewers, minLevel)
+	}
+
+	if err := uc.teamRepo.SetSeniorityRule(team, rule); err != nil {
+		return nil, err
+	}
+
+	team.SeniorityRule = rule
+	return team, nil
+}
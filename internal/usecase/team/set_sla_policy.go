@@ -0,0 +1,66 @@
+package team
+
+import (
+	"time"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetSLAPolicyUseCase struct {
+	teamRepo interfaces.TeamRepository
+}
+
+func NewSetSLAPolicyUseCase(teamRepo interfaces.TeamRepository) *SetSLAPolicyUseCase {
+	return &SetSLAPolicyUseCase{
+		teamRepo: teamRepo,
+	}
+}
+
+type SetSLAPolicyRequest struct {
+	TeamName  string
+	ReviewSLA string
+	Action    string
+	IfMatch   *int64
+}
+
+// Execute устанавливает SLA первого ревью команды. Пустой ReviewSLA снимает
+// политику, и команда возвращается к SLA по умолчанию.
+func (uc *SetSLAPolicyUseCase) Execute(req SetSLAPolicyRequest) (*domain.Team, error) {
+	var policy *domain.SLAPolicy
+	if req.ReviewSLA != "" {
+		reviewSLA, err := time.ParseDuration(req.ReviewSLA)
+		if err != nil {
+			return nil, domain.InvalidArgument("review_sla", "must be a duration such as 24h").Wrap(err)
+		}
+
+		action, err := domain.ParseEscalationAction(req.Action)
+		if err != nil {
+			return nil, err
+		}
+
+		policy, err = domain.NewSLAPolicy(reviewSLA, action)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	team, err := uc.teamRepo.GetByName(req.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, domain.NotFound(domain.EntityTeam, req.TeamName)
+	}
+
+	if err := domain.CheckVersion(team.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	if err := uc.teamRepo.SetSLAPolicy(team, policy); err != nil {
+		return nil, err
+	}
+
+	team.SLAPolicy = policy
+	return team, nil
+}
@@ -24,7 +24,7 @@ func (uc *GetReviewsUseCase) Execute(userID string) ([]*domain.PullRequest, erro
 	}
 
 	if user == nil {
-		return nil, domain.ErrNotFound
+		return nil, domain.NotFound(domain.EntityUser, userID)
 	}
 
 	prs, err := uc.prRepo.GetByReviewerID(userID)
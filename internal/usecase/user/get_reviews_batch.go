@@ -0,0 +1,22 @@
+package user
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// GetReviewsBatchUseCase — GetReviewsUseCase для нескольких пользователей
+// сразу. Неизвестные пользователи получают пустой список, а не NOT_FOUND.
+type GetReviewsBatchUseCase struct {
+	prRepo interfaces.PRRepository
+}
+
+func NewGetReviewsBatchUseCase(prRepo interfaces.PRRepository) *GetReviewsBatchUseCase {
+	return &GetReviewsBatchUseCase{
+		prRepo: prRepo,
+	}
+}
+
+func (uc *GetReviewsBatchUseCase) Execute(userIDs []string) (map[string][]*domain.PullRequest, error) {
+	return uc.prRepo.GetByReviewerIDs(userIDs)
+}
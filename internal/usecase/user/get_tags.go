@@ -0,0 +1,29 @@
+package user
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetTagsUseCase struct {
+	userRepo interfaces.UserRepository
+}
+
+func NewGetTagsUseCase(userRepo interfaces.UserRepository) *GetTagsUseCase {
+	return &GetTagsUseCase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *GetTagsUseCase) Execute(userID string) ([]string, error) {
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.NotFound(domain.EntityUser, userID)
+	}
+
+	return user.Tags, nil
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type GetUserUseCase struct {
+	userRepo interfaces.UserRepository
+}
+
+func NewGetUserUseCase(userRepo interfaces.UserRepository) *GetUserUseCase {
+	return &GetUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *GetUserUseCase) Execute(userID string) (*domain.User, error) {
+	user, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.NotFound(domain.EntityUser, userID)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// GetUsersUseCase загружает нескольких пользователей одним обращением к
+// репозиторию. Отсутствующих пользователей в результате нет.
+type GetUsersUseCase struct {
+	userRepo interfaces.UserRepository
+}
+
+func NewGetUsersUseCase(userRepo interfaces.UserRepository) *GetUsersUseCase {
+	return &GetUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *GetUsersUseCase) Execute(userIDs []string) (map[string]*domain.User, error) {
+	users, err := uc.userRepo.GetByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*domain.User, len(users))
+	for _, user := range users {
+		byID[user.UserID] = user
+	}
+
+	return byID, nil
+}
@@ -1,40 +1,202 @@
 package user
 
 import (
+	"errors"
+
 	"github.com/avito-tech-backend-autumn-2025/internal/domain"
 	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+)
+
+// Что произошло с открытым PR при смене активности ревьюера.
+const (
+	AffectedReassigned   = "REASSIGNED"
+	AffectedMarkedStale  = "MARKED_STALE"
+	AffectedStaleCleared = "STALE_CLEARED"
+	AffectedBackfilled   = "BACKFILLED"
 )
 
 type SetActiveUseCase struct {
-	userRepo interfaces.UserRepository
+	transactor interfaces.Transactor
+	reviewer   *domain.ReviewerAssigner
+	clock      domain.Clock
 }
 
-func NewSetActiveUseCase(userRepo interfaces.UserRepository) *SetActiveUseCase {
+func NewSetActiveUseCase(
+	transactor interfaces.Transactor,
+	reviewer *domain.ReviewerAssigner,
+	clock domain.Clock,
+) *SetActiveUseCase {
 	return &SetActiveUseCase{
-		userRepo: userRepo,
+		transactor: transactor,
+		reviewer:   reviewer,
+		clock:      clock,
 	}
 }
 
 type SetActiveRequest struct {
-	UserID   string
-	IsActive bool
+	UserID       string
+	IsActive     bool
+	OnDeactivate string
+	OnReactivate string
+	IfMatch      *int64
+}
+
+// AffectedPR — изменение одного открытого PR. Err — доменная причина, по
+// которой ревью не удалось переназначить.
+type AffectedPR struct {
+	PRID           string
+	Action         string
+	ReplacedBy     string
+	AddedReviewers []string
+	Err            error
+}
+
+type SetActiveResponse struct {
+	User        *domain.User
+	AffectedPRs []AffectedPR
+}
+
+// Execute меняет активность пользователя и применяет политику к его открытым
+// ревью. При деактивации ревью переназначаются (REASSIGN) или остаются за ним
+// с отметкой об устаревании (KEEP). При возвращении отметки снимаются, а с
+// BACKFILL ревьюеры добираются открытым PR команды пользователя. Смена
+// активности и все изменения PR выполняются одной транзакцией.
+func (uc *SetActiveUseCase) Execute(req SetActiveRequest) (*SetActiveResponse, error) {
+	onDeactivate, err := domain.ParseDeactivationPolicy(req.OnDeactivate)
+	if err != nil {
+		return nil, err
+	}
+	onReactivate, err := domain.ParseReactivationPolicy(req.OnReactivate)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *SetActiveResponse
+	err = uc.transactor.WithinTx(func(repos interfaces.Repositories) error {
+		user, err := repos.Users.GetByID(req.UserID)
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return domain.NotFound(domain.EntityUser, req.UserID)
+		}
+
+		if err := domain.CheckVersion(user.Version, req.IfMatch); err != nil {
+			return err
+		}
+
+		user.SetActive(req.IsActive)
+
+		if err := repos.Users.Update(user); err != nil {
+			return err
+		}
+
+		var affected []AffectedPR
+		if req.IsActive {
+			affected, err = uc.reactivate(repos, user, onReactivate)
+		} else {
+			affected, err = uc.deactivate(repos, user, onDeactivate)
+		}
+		if err != nil {
+			return err
+		}
+
+		response = &SetActiveResponse{User: user, AffectedPRs: affected}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
 }
 
-func (uc *SetActiveUseCase) Execute(req SetActiveRequest) (*domain.User, error) {
-	user, err := uc.userRepo.GetByID(req.UserID)
+func (uc *SetActiveUseCase) deactivate(repos interfaces.Repositories, user *domain.User, policy domain.DeactivationPolicy) ([]AffectedPR, error) {
+	var affected []AffectedPR
+	failed := make(map[string]error)
+
+	if policy == domain.DeactivationReassign {
+		prs, err := repos.PRs.GetByReviewerID(user.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		reassign := pr.NewReassignReviewerUseCase(repos.Tx, repos.PRs, repos.Users, repos.Teams, repos.History, uc.reviewer, uc.clock)
+		for _, pullRequest := range prs {
+			if pullRequest.Status != domain.StatusOpen {
+				continue
+			}
+
+			response, err := reassign.Execute(pr.ReassignReviewerRequest{
+				PRID:      pullRequest.ID,
+				OldUserID: user.UserID,
+			})
+			if err != nil {
+				// Доменная ошибка касается одного PR: ревью остаётся за
+				// пользователем, остальные PR обрабатываются дальше
+				var domainErr *domain.DomainError
+				if !errors.As(err, &domainErr) {
+					return nil, err
+				}
+				failed[pullRequest.ID] = err
+				continue
+			}
+
+			affected = append(affected, AffectedPR{
+				PRID:       pullRequest.ID,
+				Action:     AffectedReassigned,
+				ReplacedBy: response.ReplacedBy,
+			})
+		}
+	}
+
+	// Всё, что осталось за пользователем, помечается устаревшим
+	prIDs, err := repos.PRs.MarkReviewsStale(user.UserID, uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, prID := range prIDs {
+		affected = append(affected, AffectedPR{PRID: prID, Action: AffectedMarkedStale, Err: failed[prID]})
+	}
+
+	return affected, nil
+}
+
+// reactivate снимает отметки об устаревании, а с BACKFILL назначает
+// пользователя ревьюером открытых PR его команды, которым не хватает
+// ревьюеров. Других ревьюеров добор не добавляет.
+func (uc *SetActiveUseCase) reactivate(repos interfaces.Repositories, user *domain.User, policy domain.ReactivationPolicy) ([]AffectedPR, error) {
+	prIDs, err := repos.PRs.ClearStaleReviews(user.UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	if user == nil {
-		return nil, domain.ErrNotFound
+	affected := make([]AffectedPR, 0, len(prIDs))
+	for _, prID := range prIDs {
+		affected = append(affected, AffectedPR{PRID: prID, Action: AffectedStaleCleared})
 	}
 
-	user.SetActive(req.IsActive)
+	if policy != domain.ReactivationBackfill {
+		return affected, nil
+	}
 
-	if err := uc.userRepo.Update(user); err != nil {
+	addExtra := pr.NewAddExtraReviewerUseCase(repos.Tx, repos.PRs, repos.Users, repos.Teams, repos.History, uc.reviewer, uc.clock)
+	backfill := pr.NewBackfillReviewersUseCase(repos.PRs, addExtra)
+	report, err := backfill.Execute(pr.BackfillReviewersRequest{TeamName: user.TeamName, ReviewerID: user.UserID})
+	if err != nil {
 		return nil, err
 	}
+	for _, result := range report.Results {
+		if len(result.AddedReviewers) > 0 {
+			affected = append(affected, AffectedPR{
+				PRID:           result.PRID,
+				Action:         AffectedBackfilled,
+				AddedReviewers: result.AddedReviewers,
+			})
+		}
+	}
 
-	return user, nil
+	return affected, nil
 }
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetScheduleUseCase struct {
+	userRepo interfaces.UserRepository
+}
+
+func NewSetScheduleUseCase(userRepo interfaces.UserRepository) *SetScheduleUseCase {
+	return &SetScheduleUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// SetScheduleRequest задаёт часовой пояс и рабочее время в формате "15:04".
+// Пустые WorkStart и WorkEnd снимают ограничение по рабочему времени.
+type SetScheduleRequest struct {
+	UserID    string
+	TimeZone  string
+	WorkStart string
+	WorkEnd   string
+	IfMatch   *int64
+}
+
+func (uc *SetScheduleUseCase) Execute(req SetScheduleRequest) (*domain.User, error) {
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	if err := domain.ValidateTimeZone(timeZone); err != nil {
+		return nil, err
+	}
+
+	var workingHours *domain.WorkingHours
+	if req.WorkStart != "" || req.WorkEnd != "" {
+		hours, err := domain.ParseWorkingHours(req.WorkStart, req.WorkEnd)
+		if err != nil {
+			return nil, err
+		}
+		workingHours = hours
+	}
+
+	user, err := uc.userRepo.GetByID(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.NotFound(domain.EntityUser, req.UserID)
+	}
+
+	if err := domain.CheckVersion(user.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	user.TimeZone = timeZone
+	user.WorkingHours = workingHours
+
+	if err := uc.userRepo.Update(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
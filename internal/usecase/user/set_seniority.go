@@ -0,0 +1,50 @@
+package user
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetSeniorityUseCase struct {
+	userRepo interfaces.UserRepository
+}
+
+func NewSetSeniorityUseCase(userRepo interfaces.UserRepository) *SetSeniorityUseCase {
+	return &SetSeniorityUseCase{
+		userRepo: userRepo,
+	}
+}
+
+type SetSeniorityRequest struct {
+	UserID    string
+	Seniority string
+	IfMatch   *int64
+}
+
+func (uc *SetSeniorityUseCase) Execute(req SetSeniorityRequest) (*domain.User, error) {
+	seniority, err := domain.ParseSeniority(req.Seniority)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.NotFound(domain.EntityUser, req.UserID)
+	}
+
+	if err := domain.CheckVersion(user.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	user.Seniority = seniority
+
+	if err := uc.userRepo.Update(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,45 @@
+package user
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+type SetTagsUseCase struct {
+	userRepo interfaces.UserRepository
+}
+
+func NewSetTagsUseCase(userRepo interfaces.UserRepository) *SetTagsUseCase {
+	return &SetTagsUseCase{
+		userRepo: userRepo,
+	}
+}
+
+type SetTagsRequest struct {
+	UserID  string
+	Tags    []string
+	IfMatch *int64
+}
+
+func (uc *SetTagsUseCase) Execute(req SetTagsRequest) (*domain.User, error) {
+	user, err := uc.userRepo.GetByID(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.NotFound(domain.EntityUser, req.UserID)
+	}
+
+	if err := domain.CheckVersion(user.Version, req.IfMatch); err != nil {
+		return nil, err
+	}
+
+	user.SetTags(req.Tags)
+
+	if err := uc.userRepo.SetTags(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/interfaces"
+)
+
+// UpdateUserUseCase частично обновляет пользователя: меняются только заданные
+// поля. Уровень меняется раньше активности, чтобы переназначение при
+// деактивации уже учитывало новый уровень. Все изменения выполняются одной
+// транзакцией.
+type UpdateUserUseCase struct {
+	transactor interfaces.Transactor
+	reviewer   *domain.ReviewerAssigner
+	clock      domain.Clock
+}
+
+func NewUpdateUserUseCase(
+	transactor interfaces.Transactor,
+	reviewer *domain.ReviewerAssigner,
+	clock domain.Clock,
+) *UpdateUserUseCase {
+	return &UpdateUserUseCase{
+		transactor: transactor,
+		reviewer:   reviewer,
+		clock:      clock,
+	}
+}
+
+// UpdateUserRequest — nil-поля не меняются. OnDeactivate и OnReactivate
+// учитываются только вместе с IsActive. IfMatch сверяется с версией до первого
+// изменения.
+type UpdateUserRequest struct {
+	UserID       string
+	IsActive     *bool
+	OnDeactivate string
+	OnReactivate string
+	Seniority    *string
+	IfMatch      *int64
+}
+
+func (uc *UpdateUserUseCase) Execute(req UpdateUserRequest) (*SetActiveResponse, error) {
+	if req.IsActive == nil && req.Seniority == nil {
+		return nil, domain.InvalidArgument("is_active", "is_active or seniority is required")
+	}
+
+	if req.Seniority != nil {
+		if _, err := domain.ParseSeniority(*req.Seniority); err != nil {
+			return nil, err
+		}
+	}
+	if req.IsActive != nil {
+		if _, err := domain.ParseDeactivationPolicy(req.OnDeactivate); err != nil {
+			return nil, err
+		}
+		if _, err := domain.ParseReactivationPolicy(req.OnReactivate); err != nil {
+			return nil, err
+		}
+	}
+
+	var response *SetActiveResponse
+	err := uc.transactor.WithinTx(func(repos interfaces.Repositories) error {
+		response = &SetActiveResponse{}
+		ifMatch := req.IfMatch
+		if req.Seniority != nil {
+			user, err := NewSetSeniorityUseCase(repos.Users).Execute(SetSeniorityRequest{
+				UserID:    req.UserID,
+				Seniority: *req.Seniority,
+				IfMatch:   ifMatch,
+			})
+			if err != nil {
+				return err
+			}
+			response.User = user
+			ifMatch = nil
+		}
+
+		if req.IsActive != nil {
+			var err error
+			response, err = NewSetActiveUseCase(repos.Tx, uc.reviewer, uc.clock).Execute(SetActiveRequest{
+				UserID:       req.UserID,
+				IsActive:     *req.IsActive,
+				OnDeactivate: req.OnDeactivate,
+				OnReactivate: req.OnReactivate,
+				IfMatch:      ifMatch,
+			})
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
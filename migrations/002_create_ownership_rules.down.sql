@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ownership_rule_users;
+DROP TABLE IF EXISTS ownership_rules;
\ No newline at end of file
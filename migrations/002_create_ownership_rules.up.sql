@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS ownership_rules (
+    rule_id SERIAL PRIMARY KEY,
+    position INTEGER NOT NULL,
+    pattern VARCHAR(255) NOT NULL,
+    team_name VARCHAR(255) REFERENCES teams(team_name) ON DELETE CASCADE
+);
+
+
+CREATE INDEX IF NOT EXISTS idx_ownership_rules_position ON ownership_rules(position);
+
+
+CREATE TABLE IF NOT EXISTS ownership_rule_users (
+    rule_id INTEGER NOT NULL REFERENCES ownership_rules(rule_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (rule_id, user_id)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS team_seniority_rules;
+ALTER TABLE users DROP COLUMN IF EXISTS seniority;
\ No newline at end of file
@@ -0,0 +1,8 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS seniority VARCHAR(16) NOT NULL DEFAULT 'middle';
+
+
+CREATE TABLE IF NOT EXISTS team_seniority_rules (
+    team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    min_reviewers INTEGER NOT NULL,
+    min_level VARCHAR(16) NOT NULL
+);
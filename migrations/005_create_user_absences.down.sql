@@ -0,0 +1 @@
+DROP TABLE IF EXISTS user_absences;
\ No newline at end of file
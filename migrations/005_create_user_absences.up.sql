@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS user_absences (
+    absence_id SERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (end_date >= start_date)
+);
+
+
+CREATE INDEX IF NOT EXISTS idx_user_absences_user_end ON user_absences(user_id, end_date);
+CREATE INDEX IF NOT EXISTS idx_user_absences_start_date ON user_absences(start_date);
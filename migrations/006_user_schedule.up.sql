@@ -0,0 +1,15 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_start_minute SMALLINT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS work_end_minute SMALLINT;
+
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint
+        WHERE conname = 'users_working_hours_check' AND conrelid = 'users'::regclass
+    ) THEN
+        ALTER TABLE users ADD CONSTRAINT users_working_hours_check
+            CHECK ((work_start_minute IS NULL) = (work_end_minute IS NULL) AND work_start_minute < work_end_minute);
+    END IF;
+END $$;
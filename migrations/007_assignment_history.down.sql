@@ -0,0 +1 @@
+DROP TABLE IF EXISTS assignment_history;
\ No newline at end of file
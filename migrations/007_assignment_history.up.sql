@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS assignment_history (
+    history_id SERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    action VARCHAR(32) NOT NULL,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    replaced_reviewer_id VARCHAR(255) REFERENCES users(user_id) ON DELETE SET NULL,
+    seed BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+
+CREATE INDEX IF NOT EXISTS idx_assignment_history_pr_id ON assignment_history(pull_request_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS escalations;
+DROP TABLE IF EXISTS team_sla_policies;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS team_sla_policies (
+    team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    review_sla_seconds BIGINT NOT NULL CHECK (review_sla_seconds > 0),
+    action VARCHAR(32) NOT NULL
+);
+
+
+CREATE TABLE IF NOT EXISTS escalations (
+    escalation_id SERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    action VARCHAR(32) NOT NULL,
+    new_reviewer_id VARCHAR(255) REFERENCES users(user_id) ON DELETE SET NULL,
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+
+CREATE INDEX IF NOT EXISTS idx_escalations_pr_reviewer ON escalations(pull_request_id, reviewer_id);
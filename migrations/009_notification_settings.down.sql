@@ -0,0 +1 @@
+DROP TABLE IF EXISTS notification_settings;
\ No newline at end of file
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS notification_settings (
+    user_id VARCHAR(255) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    frequency VARCHAR(16) NOT NULL DEFAULT 'DAILY',
+    last_sent_at TIMESTAMP
+);
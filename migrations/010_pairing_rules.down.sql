@@ -0,0 +1 @@
+DROP TABLE IF EXISTS team_pairing_rules;
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS team_pairing_rules (
+    rule_id SERIAL PRIMARY KEY,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    rule_type VARCHAR(32) NOT NULL,
+    author_id VARCHAR(255) REFERENCES users(user_id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+
+CREATE INDEX IF NOT EXISTS idx_team_pairing_rules_team_name ON team_pairing_rules(team_name);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS assignment_decisions;
+DROP TABLE IF EXISTS assignment_reasoning;
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS assignment_reasoning (
+    reasoning_id SERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    action VARCHAR(32) NOT NULL,
+    seed BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+
+CREATE TABLE IF NOT EXISTS assignment_decisions (
+    reasoning_id INTEGER NOT NULL REFERENCES assignment_reasoning(reasoning_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    rank INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (reasoning_id, position)
+);
+
+
+CREATE INDEX IF NOT EXISTS idx_assignment_reasoning_pr_id ON assignment_reasoning(pull_request_id);
@@ -0,0 +1,2 @@
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS fallback_team;
+DROP TABLE IF EXISTS team_fallbacks;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    fallback_team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    PRIMARY KEY (team_name, position),
+    UNIQUE (team_name, fallback_team_name),
+    CHECK (team_name <> fallback_team_name)
+);
+
+
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS fallback_team VARCHAR(255);
@@ -0,0 +1 @@
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS stale_since;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS stale_since TIMESTAMP;
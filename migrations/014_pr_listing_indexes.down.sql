@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pr_status_created_at;
+DROP INDEX IF EXISTS idx_pr_author_created_at;
+DROP INDEX IF EXISTS idx_pr_created_at;
\ No newline at end of file
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_pr_created_at ON pull_requests(created_at DESC, pull_request_id DESC);
+CREATE INDEX IF NOT EXISTS idx_pr_author_created_at ON pull_requests(author_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_pr_status_created_at ON pull_requests(status, created_at DESC);
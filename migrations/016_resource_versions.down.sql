@@ -0,0 +1,4 @@
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS version;
+ALTER TABLE users DROP COLUMN IF EXISTS version;
+ALTER TABLE teams DROP COLUMN IF EXISTS version;
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_escalations_pr_reviewer;
+CREATE INDEX IF NOT EXISTS idx_escalations_pr_reviewer ON escalations(pull_request_id, reviewer_id);
@@ -0,0 +1,8 @@
+DELETE FROM escalations e
+USING escalations earlier
+WHERE e.pull_request_id = earlier.pull_request_id
+  AND e.reviewer_id = earlier.reviewer_id
+  AND e.escalation_id > earlier.escalation_id;
+
+DROP INDEX IF EXISTS idx_escalations_pr_reviewer;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_escalations_pr_reviewer ON escalations(pull_request_id, reviewer_id);
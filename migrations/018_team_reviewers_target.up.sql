@@ -0,0 +1 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS reviewers_target INT NOT NULL DEFAULT 0 CHECK (reviewers_target >= 0);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_user_absences_unprocessed;
+
+ALTER TABLE user_absences DROP COLUMN IF EXISTS processed_at;
@@ -0,0 +1,3 @@
+ALTER TABLE user_absences ADD COLUMN IF NOT EXISTS processed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_user_absences_unprocessed ON user_absences(start_date) WHERE processed_at IS NULL;
@@ -2,40 +2,115 @@ package helpers
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/avito-tech-backend-autumn-2025/api"
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/gql"
 	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/handlers"
 	"github.com/avito-tech-backend-autumn-2025/internal/domain"
 	"github.com/avito-tech-backend-autumn-2025/internal/repository/postgres"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/absence"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/escalation"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/idempotency"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/ownership"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/reminder"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/team"
 	"github.com/avito-tech-backend-autumn-2025/internal/usecase/user"
 	"github.com/gin-gonic/gin"
 )
 
 func SetupTestApp(db *sql.DB) *gin.Engine {
+	return SetupTestAppWith(db, domain.SystemClock{}, domain.NewSystemRandomSource())
+}
+
+// SetupTestAppWith собирает приложение с заданными часами и источником
+// случайности, чтобы тесты назначения были детерминированными.
+func SetupTestAppWith(db *sql.DB, clock domain.Clock, random domain.RandomSource) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 
 	teamRepo := postgres.NewTeamRepository(db)
 	userRepo := postgres.NewUserRepository(db)
 	prRepo := postgres.NewPRRepository(db)
+	ownershipRepo := postgres.NewOwnershipRepository(db)
+	absenceRepo := postgres.NewAbsenceRepository(db)
+	historyRepo := postgres.NewAssignmentHistoryRepository(db)
+	escalationRepo := postgres.NewEscalationRepository(db)
+	notificationSettingsRepo := postgres.NewNotificationSettingsRepository(db)
+	idempotencyRepo := postgres.NewIdempotencyRepository(db)
+	transactor := postgres.NewTransactor(db)
 
-	reviewerAssigner := domain.NewReviewerAssigner()
+	reviewerAssigner := domain.NewReviewerAssigner(clock, random, 24*time.Hour, domain.FairnessPolicy{Window: 50, MaxSkew: 2})
 
 	createTeamUseCase := team.NewCreateTeamUseCase(teamRepo, userRepo)
 	getTeamUseCase := team.NewGetTeamUseCase(teamRepo)
-	setActiveUseCase := user.NewSetActiveUseCase(userRepo)
+	listTeamsUseCase := team.NewListTeamsUseCase(teamRepo)
+	setSeniorityRuleUseCase := team.NewSetSeniorityRuleUseCase(teamRepo)
+	setSLAPolicyUseCase := team.NewSetSLAPolicyUseCase(teamRepo)
+	setPairingRulesUseCase := team.NewSetPairingRulesUseCase(teamRepo)
+	explainPairingUseCase := team.NewExplainPairingUseCase(teamRepo, userRepo, clock)
+	setFallbackTeamsUseCase := team.NewSetFallbackTeamsUseCase(teamRepo)
+	setReviewersTargetUseCase := team.NewSetReviewersTargetUseCase(teamRepo)
 	getReviewsUseCase := user.NewGetReviewsUseCase(prRepo, userRepo)
-	createPRUseCase := pr.NewCreatePRUseCase(prRepo, userRepo, teamRepo, reviewerAssigner)
-	mergePRUseCase := pr.NewMergePRUseCase(prRepo)
-	reassignReviewerUseCase := pr.NewReassignReviewerUseCase(prRepo, userRepo, teamRepo, reviewerAssigner)
+	getUsersUseCase := user.NewGetUsersUseCase(userRepo)
+	getReviewsBatchUseCase := user.NewGetReviewsBatchUseCase(prRepo)
+	setTagsUseCase := user.NewSetTagsUseCase(userRepo)
+	getTagsUseCase := user.NewGetTagsUseCase(userRepo)
+	getUserUseCase := user.NewGetUserUseCase(userRepo)
+	setSeniorityUseCase := user.NewSetSeniorityUseCase(userRepo)
+	setScheduleUseCase := user.NewSetScheduleUseCase(userRepo)
+	createPRUseCase := pr.NewCreatePRUseCase(transactor, prRepo, userRepo, teamRepo, ownershipRepo, historyRepo, reviewerAssigner, clock)
+	mergePRUseCase := pr.NewMergePRUseCase(prRepo, clock)
+	getPRUseCase := pr.NewGetPRUseCase(prRepo)
+	listPRsUseCase := pr.NewListPRsUseCase(prRepo)
+	getPRsUseCase := pr.NewGetPRsUseCase(prRepo)
+	reassignReviewerUseCase := pr.NewReassignReviewerUseCase(transactor, prRepo, userRepo, teamRepo, historyRepo, reviewerAssigner, clock)
+	getHistoryUseCase := pr.NewGetHistoryUseCase(prRepo, historyRepo)
+	previewPRUseCase := pr.NewPreviewPRUseCase(userRepo, teamRepo, ownershipRepo, historyRepo, reviewerAssigner)
+	getReasoningUseCase := pr.NewGetReasoningUseCase(prRepo, historyRepo)
+	addReviewerUseCase := pr.NewAddReviewerUseCase(transactor, prRepo, userRepo, teamRepo, clock)
+	removeReviewerUseCase := pr.NewRemoveReviewerUseCase(transactor, prRepo, userRepo, teamRepo, clock)
+	batchCreatePRsUseCase := pr.NewBatchCreatePRsUseCase(transactor, ownershipRepo, reviewerAssigner, clock)
+	batchMergePRsUseCase := pr.NewBatchMergePRsUseCase(transactor, clock)
+	batchReassignReviewersUseCase := pr.NewBatchReassignReviewersUseCase(transactor, reviewerAssigner, clock)
+	addExtraReviewerUseCase := pr.NewAddExtraReviewerUseCase(transactor, prRepo, userRepo, teamRepo, historyRepo, reviewerAssigner, clock)
+	backfillReviewersUseCase := pr.NewBackfillReviewersUseCase(prRepo, addExtraReviewerUseCase)
+	setActiveUseCase := user.NewSetActiveUseCase(transactor, reviewerAssigner, clock)
+	updateUserUseCase := user.NewUpdateUserUseCase(transactor, reviewerAssigner, clock)
+	getEscalationsUseCase := escalation.NewGetEscalationsUseCase(prRepo, escalationRepo)
+	setOwnershipRulesUseCase := ownership.NewSetRulesUseCase(ownershipRepo, teamRepo, userRepo)
+	getOwnershipRulesUseCase := ownership.NewGetRulesUseCase(ownershipRepo)
+	explainOwnershipUseCase := ownership.NewExplainUseCase(ownershipRepo)
+	addAbsenceUseCase := absence.NewAddAbsenceUseCase(absenceRepo, userRepo)
+	getAbsencesUseCase := absence.NewGetAbsencesUseCase(absenceRepo, userRepo)
+	deleteAbsenceUseCase := absence.NewDeleteAbsenceUseCase(absenceRepo)
+	setNotificationSettingsUseCase := reminder.NewSetSettingsUseCase(notificationSettingsRepo, userRepo)
+	getNotificationSettingsUseCase := reminder.NewGetSettingsUseCase(notificationSettingsRepo, userRepo)
+	beginIdempotentUseCase := idempotency.NewBeginUseCase(idempotencyRepo, clock, 24*time.Hour)
+	completeIdempotentUseCase := idempotency.NewCompleteUseCase(idempotencyRepo)
 
-	teamHandler := handlers.NewTeamHandler(createTeamUseCase, getTeamUseCase)
-	userHandler := handlers.NewUserHandler(setActiveUseCase, getReviewsUseCase)
-	prHandler := handlers.NewPRHandler(createPRUseCase, mergePRUseCase, reassignReviewerUseCase)
+	teamHandler := handlers.NewTeamHandler(createTeamUseCase, getTeamUseCase, setSeniorityRuleUseCase, setSLAPolicyUseCase, setPairingRulesUseCase, explainPairingUseCase, setFallbackTeamsUseCase, setReviewersTargetUseCase)
+	userHandler := handlers.NewUserHandler(setActiveUseCase, getReviewsUseCase, setTagsUseCase, getTagsUseCase, setSeniorityUseCase, setScheduleUseCase)
+	prHandler := handlers.NewPRHandler(createPRUseCase, mergePRUseCase, reassignReviewerUseCase, getHistoryUseCase, getEscalationsUseCase, previewPRUseCase, getReasoningUseCase, addReviewerUseCase, removeReviewerUseCase, backfillReviewersUseCase, getPRUseCase, listPRsUseCase, batchCreatePRsUseCase, batchMergePRsUseCase, batchReassignReviewersUseCase)
+	ownershipHandler := handlers.NewOwnershipHandler(setOwnershipRulesUseCase, getOwnershipRulesUseCase, explainOwnershipUseCase)
+	absenceHandler := handlers.NewAbsenceHandler(addAbsenceUseCase, getAbsencesUseCase, deleteAbsenceUseCase)
+	notificationHandler := handlers.NewNotificationHandler(setNotificationSettingsUseCase, getNotificationSettingsUseCase)
 	healthHandler := handlers.NewHealthHandler()
 
-	router := api.NewRouter(teamHandler, userHandler, prHandler, healthHandler)
+	graphQLExecutor, err := gql.NewExecutor(
+		createTeamUseCase, getTeamUseCase, getUsersUseCase, getReviewsBatchUseCase, setActiveUseCase,
+		getPRsUseCase, createPRUseCase, mergePRUseCase, reassignReviewerUseCase, addReviewerUseCase, removeReviewerUseCase,
+	)
+	if err != nil {
+		panic(err)
+	}
+	graphQLHandler := handlers.NewGraphQLHandler(graphQLExecutor)
+	teamV2Handler := handlers.NewTeamV2Handler(createTeamUseCase, getTeamUseCase, listTeamsUseCase)
+	userV2Handler := handlers.NewUserV2Handler(getUserUseCase, updateUserUseCase)
+	prV2Handler := handlers.NewPRV2Handler(getPRUseCase, reassignReviewerUseCase)
+	idempotencyMiddleware := handlers.NewIdempotencyMiddleware(beginIdempotentUseCase, completeIdempotentUseCase)
+
+	router := api.NewRouter(teamHandler, userHandler, prHandler, ownershipHandler, absenceHandler, notificationHandler, graphQLHandler, teamV2Handler, userV2Handler, prV2Handler, idempotencyMiddleware, healthHandler)
 
 	return router
 }
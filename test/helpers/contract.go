@@ -0,0 +1,109 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+)
+
+// Contract проверяет обмен с API по спецификации OpenAPI: маршрут должен быть
+// описан, статус ответа — перечислен в responses операции, заголовки и тело —
+// соответствовать схеме. Вызванные операции запоминаются, чтобы тест мог
+// убедиться, что спецификация покрыта целиком.
+type Contract struct {
+	handler http.Handler
+	doc     *openapi3.T
+	router  routers.Router
+	called  map[string]bool
+}
+
+// LoadContract загружает спецификацию из path и проверяет, что она корректна,
+// включая примеры.
+func LoadContract(handler http.Handler, path string) (*Contract, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("invalid specification %s: %w", path, err)
+	}
+
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build router for %s: %w", path, err)
+	}
+
+	return &Contract{
+		handler: handler,
+		doc:     doc,
+		router:  router,
+		called:  make(map[string]bool),
+	}, nil
+}
+
+// Perform выполняет запрос как PerformRequestWithHeaders и сверяет его со
+// спецификацией. Запрос проверяется только при ответе без ошибки: ответы 4xx
+// тесты получают намеренно неверными запросами.
+func (c *Contract) Perform(method, path string, body interface{}, headers map[string]string) (*httptest.ResponseRecorder, error) {
+	w := PerformRequestWithHeaders(c.handler, method, path, body, headers)
+
+	req := newRequest(method, path, body, headers)
+	route, pathParams, err := c.router.FindRoute(req)
+	if err != nil {
+		return w, fmt.Errorf("%s %s is not described: %w", method, path, err)
+	}
+	c.called[operationName(route.Method, route.Path)] = true
+
+	ctx := context.Background()
+	requestInput := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    &openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
+	}
+	if w.Code < http.StatusBadRequest {
+		if err := openapi3filter.ValidateRequest(ctx, requestInput); err != nil {
+			return w, fmt.Errorf("%s %s: request does not match the specification: %w", method, path, err)
+		}
+	}
+
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput,
+		Status:                 w.Code,
+		Header:                 w.Header(),
+		Options:                &openapi3filter.Options{IncludeResponseStatus: true, MultiError: true},
+	}
+	responseInput.SetBodyBytes(w.Body.Bytes())
+	if err := openapi3filter.ValidateResponse(ctx, responseInput); err != nil {
+		return w, fmt.Errorf("%s %s: response %d does not match the specification: %w\n%s", method, path, w.Code, err, w.Body.String())
+	}
+
+	return w, nil
+}
+
+// Uncovered возвращает операции спецификации, которые ещё не вызывались.
+func (c *Contract) Uncovered() []string {
+	var uncovered []string
+	for path, item := range c.doc.Paths.Map() {
+		for method := range item.Operations() {
+			if name := operationName(method, path); !c.called[name] {
+				uncovered = append(uncovered, name)
+			}
+		}
+	}
+	sort.Strings(uncovered)
+	return uncovered
+}
+
+func operationName(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/rpc"
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/postgres"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/team"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/user"
+)
+
+// SetupTestGRPC поднимает gRPC-сервер в памяти поверх bufconn и возвращает
+// подключённого к нему клиента. cleanup останавливает сервер и закрывает
+// соединение.
+func SetupTestGRPC(db *sql.DB) (*grpc.ClientConn, func(), error) {
+	clock := domain.SystemClock{}
+
+	teamRepo := postgres.NewTeamRepository(db)
+	userRepo := postgres.NewUserRepository(db)
+	prRepo := postgres.NewPRRepository(db)
+	ownershipRepo := postgres.NewOwnershipRepository(db)
+	historyRepo := postgres.NewAssignmentHistoryRepository(db)
+	transactor := postgres.NewTransactor(db)
+
+	reviewerAssigner := domain.NewReviewerAssigner(clock, domain.NewSystemRandomSource(), 24*time.Hour, domain.FairnessPolicy{Window: 50, MaxSkew: 2})
+
+	createTeamUseCase := team.NewCreateTeamUseCase(teamRepo, userRepo)
+	getTeamUseCase := team.NewGetTeamUseCase(teamRepo)
+	getReviewsUseCase := user.NewGetReviewsUseCase(prRepo, userRepo)
+	createPRUseCase := pr.NewCreatePRUseCase(transactor, prRepo, userRepo, teamRepo, ownershipRepo, historyRepo, reviewerAssigner, clock)
+	mergePRUseCase := pr.NewMergePRUseCase(prRepo, clock)
+	reassignReviewerUseCase := pr.NewReassignReviewerUseCase(transactor, prRepo, userRepo, teamRepo, historyRepo, reviewerAssigner, clock)
+	addReviewerUseCase := pr.NewAddReviewerUseCase(transactor, prRepo, userRepo, teamRepo, clock)
+	removeReviewerUseCase := pr.NewRemoveReviewerUseCase(transactor, prRepo, userRepo, teamRepo, clock)
+	setActiveUseCase := user.NewSetActiveUseCase(transactor, reviewerAssigner, clock)
+
+	server := rpc.NewServer(
+		rpc.NewTeamServer(createTeamUseCase, getTeamUseCase),
+		rpc.NewUserServer(setActiveUseCase, getReviewsUseCase),
+		rpc.NewPRServer(createPRUseCase, mergePRUseCase, reassignReviewerUseCase, addReviewerUseCase, removeReviewerUseCase),
+	)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		server.Stop()
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		conn.Close()
+		server.Stop()
+	}
+
+	return conn, cleanup, nil
+}
@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+)
+
+func PerformRequest(handler http.Handler, method, path string, body interface{}) *httptest.ResponseRecorder {
+	return PerformRequestWithHeaders(handler, method, path, body, nil)
+}
+
+// PerformRequestWithHeaders — PerformRequest с дополнительными заголовками запроса.
+func PerformRequestWithHeaders(handler http.Handler, method, path string, body interface{}, headers map[string]string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newRequest(method, path, body, headers))
+
+	return w
+}
+
+// newRequest собирает запрос с телом body в JSON и заголовками headers.
+func newRequest(method, path string, body interface{}, headers map[string]string) *http.Request {
+	var reader io.Reader
+	if body != nil {
+		payload, _ := json.Marshal(body)
+		reader = bytes.NewBuffer(payload)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	return req
+}
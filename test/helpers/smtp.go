@@ -0,0 +1,132 @@
+package helpers
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+)
+
+type SMTPMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// FakeSMTPServer — минимальный SMTP-сервер для тестов: принимает письма без
+// аутентификации и TLS и сохраняет их в памяти.
+type FakeSMTPServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	messages []SMTPMessage
+	wg       sync.WaitGroup
+}
+
+func StartFakeSMTPServer() (*FakeSMTPServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	server := &FakeSMTPServer{listener: listener}
+	server.wg.Add(1)
+	go server.serve()
+
+	return server, nil
+}
+
+func (s *FakeSMTPServer) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+func (s *FakeSMTPServer) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *FakeSMTPServer) Messages() []SMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SMTPMessage(nil), s.messages...)
+}
+
+func (s *FakeSMTPServer) Close() {
+	s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *FakeSMTPServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *FakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 fake-smtp ready")
+	var message SMTPMessage
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 fake-smtp")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			message = SMTPMessage{From: extractAddress(line)}
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			message.To = append(message.To, extractAddress(line))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			message.Data = data.String()
+			s.mu.Lock()
+			s.messages = append(s.messages, message)
+			s.mu.Unlock()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func extractAddress(line string) string {
+	start := strings.Index(line, "<")
+	end := strings.LastIndex(line, ">")
+	if start < 0 || end <= start {
+		return ""
+	}
+	return line[start+1 : end]
+}
@@ -62,7 +62,9 @@ func applyMigrations(db *sql.DB) error {
 	CREATE TABLE IF NOT EXISTS teams (
 		team_name VARCHAR(255) PRIMARY KEY,
 		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-		updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+		updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		version BIGINT NOT NULL DEFAULT 1,
+		reviewers_target INT NOT NULL DEFAULT 0 CHECK (reviewers_target >= 0)
 	);
 
 	CREATE TABLE IF NOT EXISTS users (
@@ -70,8 +72,15 @@ func applyMigrations(db *sql.DB) error {
 		username VARCHAR(255) NOT NULL,
 		team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
 		is_active BOOLEAN NOT NULL DEFAULT true,
+		seniority VARCHAR(16) NOT NULL DEFAULT 'middle',
+		time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+		work_start_minute SMALLINT,
+		work_end_minute SMALLINT,
 		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-		updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+		updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		version BIGINT NOT NULL DEFAULT 1,
+		CONSTRAINT users_working_hours_check
+			CHECK ((work_start_minute IS NULL) = (work_end_minute IS NULL) AND work_start_minute < work_end_minute)
 	);
 
 	CREATE INDEX IF NOT EXISTS idx_users_team_name ON users(team_name);
@@ -84,7 +93,9 @@ func applyMigrations(db *sql.DB) error {
 		author_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
 		status VARCHAR(50) NOT NULL DEFAULT 'OPEN',
 		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-		merged_at TIMESTAMP
+		merged_at TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		version BIGINT NOT NULL DEFAULT 1
 	);
 
 	CREATE INDEX IF NOT EXISTS idx_pr_author_id ON pull_requests(author_id);
@@ -95,11 +106,155 @@ func applyMigrations(db *sql.DB) error {
 		pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
 		reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
 		assigned_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		fallback_team VARCHAR(255),
+		stale_since TIMESTAMP,
 		PRIMARY KEY (pull_request_id, reviewer_id)
 	);
 
 	CREATE INDEX IF NOT EXISTS idx_pr_reviewers_reviewer_id ON pr_reviewers(reviewer_id);
 	CREATE INDEX IF NOT EXISTS idx_pr_reviewers_pr_id ON pr_reviewers(pull_request_id);
+
+	CREATE TABLE IF NOT EXISTS ownership_rules (
+		rule_id SERIAL PRIMARY KEY,
+		position INTEGER NOT NULL,
+		pattern VARCHAR(255) NOT NULL,
+		team_name VARCHAR(255) REFERENCES teams(team_name) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_ownership_rules_position ON ownership_rules(position);
+
+	CREATE TABLE IF NOT EXISTS ownership_rule_users (
+		rule_id INTEGER NOT NULL REFERENCES ownership_rules(rule_id) ON DELETE CASCADE,
+		user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+		PRIMARY KEY (rule_id, user_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS user_tags (
+		user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+		tag VARCHAR(64) NOT NULL,
+		PRIMARY KEY (user_id, tag)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_user_tags_tag ON user_tags(tag);
+
+	CREATE TABLE IF NOT EXISTS pr_labels (
+		pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+		label VARCHAR(64) NOT NULL,
+		PRIMARY KEY (pull_request_id, label)
+	);
+
+	CREATE TABLE IF NOT EXISTS team_seniority_rules (
+		team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+		min_reviewers INTEGER NOT NULL,
+		min_level VARCHAR(16) NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS user_absences (
+		absence_id SERIAL PRIMARY KEY,
+		user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+		start_date DATE NOT NULL,
+		end_date DATE NOT NULL,
+		reason VARCHAR(255) NOT NULL DEFAULT '',
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		processed_at TIMESTAMP,
+		CHECK (end_date >= start_date)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_user_absences_user_end ON user_absences(user_id, end_date);
+	CREATE INDEX IF NOT EXISTS idx_user_absences_start_date ON user_absences(start_date);
+	CREATE INDEX IF NOT EXISTS idx_user_absences_unprocessed ON user_absences(start_date) WHERE processed_at IS NULL;
+
+	CREATE TABLE IF NOT EXISTS assignment_history (
+		history_id SERIAL PRIMARY KEY,
+		pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+		action VARCHAR(32) NOT NULL,
+		reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+		replaced_reviewer_id VARCHAR(255) REFERENCES users(user_id) ON DELETE SET NULL,
+		seed BIGINT NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_assignment_history_pr_id ON assignment_history(pull_request_id);
+
+	CREATE TABLE IF NOT EXISTS team_sla_policies (
+		team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+		review_sla_seconds BIGINT NOT NULL CHECK (review_sla_seconds > 0),
+		action VARCHAR(32) NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS escalations (
+		escalation_id SERIAL PRIMARY KEY,
+		pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+		reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+		action VARCHAR(32) NOT NULL,
+		new_reviewer_id VARCHAR(255) REFERENCES users(user_id) ON DELETE SET NULL,
+		details TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_escalations_pr_reviewer ON escalations(pull_request_id, reviewer_id);
+
+	CREATE TABLE IF NOT EXISTS notification_settings (
+		user_id VARCHAR(255) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+		email VARCHAR(255) NOT NULL DEFAULT '',
+		frequency VARCHAR(16) NOT NULL DEFAULT 'DAILY',
+		last_sent_at TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS team_pairing_rules (
+		rule_id SERIAL PRIMARY KEY,
+		team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+		rule_type VARCHAR(32) NOT NULL,
+		author_id VARCHAR(255) REFERENCES users(user_id) ON DELETE CASCADE,
+		reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_team_pairing_rules_team_name ON team_pairing_rules(team_name);
+
+	CREATE TABLE IF NOT EXISTS assignment_reasoning (
+		reasoning_id SERIAL PRIMARY KEY,
+		pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+		action VARCHAR(32) NOT NULL,
+		seed BIGINT NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+
+	CREATE TABLE IF NOT EXISTS assignment_decisions (
+		reasoning_id INTEGER NOT NULL REFERENCES assignment_reasoning(reasoning_id) ON DELETE CASCADE,
+		position INTEGER NOT NULL,
+		user_id VARCHAR(255) NOT NULL,
+		status VARCHAR(32) NOT NULL,
+		reason VARCHAR(32) NOT NULL,
+		rank INTEGER NOT NULL DEFAULT 0,
+		PRIMARY KEY (reasoning_id, position)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_assignment_reasoning_pr_id ON assignment_reasoning(pull_request_id);
+
+	CREATE TABLE IF NOT EXISTS team_fallbacks (
+		team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+		position INTEGER NOT NULL,
+		fallback_team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+		PRIMARY KEY (team_name, position),
+		UNIQUE (team_name, fallback_team_name),
+		CHECK (team_name <> fallback_team_name)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_pr_created_at ON pull_requests(created_at DESC, pull_request_id DESC);
+	CREATE INDEX IF NOT EXISTS idx_pr_author_created_at ON pull_requests(author_id, created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_pr_status_created_at ON pull_requests(status, created_at DESC);
+
+	CREATE TABLE IF NOT EXISTS idempotency_keys (
+		idempotency_key VARCHAR(255) PRIMARY KEY,
+		request_hash CHAR(64) NOT NULL,
+		status_code INT,
+		response_headers JSONB,
+		response_body BYTEA,
+		created_at TIMESTAMP NOT NULL,
+		expires_at TIMESTAMP NOT NULL
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
 	`
 
 	_, err := db.Exec(migrationSQL)
@@ -108,6 +263,21 @@ func applyMigrations(db *sql.DB) error {
 
 func CleanupDB(db *sql.DB) error {
 	_, err := db.Exec(`
+		TRUNCATE TABLE idempotency_keys CASCADE;
+		TRUNCATE TABLE team_fallbacks CASCADE;
+		TRUNCATE TABLE assignment_decisions CASCADE;
+		TRUNCATE TABLE assignment_reasoning CASCADE;
+		TRUNCATE TABLE team_pairing_rules CASCADE;
+		TRUNCATE TABLE notification_settings CASCADE;
+		TRUNCATE TABLE escalations CASCADE;
+		TRUNCATE TABLE team_sla_policies CASCADE;
+		TRUNCATE TABLE assignment_history CASCADE;
+		TRUNCATE TABLE user_absences CASCADE;
+		TRUNCATE TABLE team_seniority_rules CASCADE;
+		TRUNCATE TABLE pr_labels CASCADE;
+		TRUNCATE TABLE user_tags CASCADE;
+		TRUNCATE TABLE ownership_rule_users CASCADE;
+		TRUNCATE TABLE ownership_rules CASCADE;
 		TRUNCATE TABLE pr_reviewers CASCADE;
 		TRUNCATE TABLE pull_requests CASCADE;
 		TRUNCATE TABLE users CASCADE;
@@ -0,0 +1,159 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/postgres"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/absence"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/pr"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_AbsenceEndpoints(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+	today := time.Now().Format("2006-01-02")
+
+	createTeam := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "David", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	// Тест проверяет, что отсутствующий пользователь не назначается ревьюером.
+	// Ожидается: u2 в отпуске сегодня и не попадает в ревьюеры, статус 201.
+	t.Run("CreatePR - skips absent users", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/addAbsence", map[string]interface{}{
+			"user_id":    "u2",
+			"start_date": today,
+			"end_date":   today,
+			"reason":     "sick day",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		reviewers := response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		assert.ElementsMatch(t, []interface{}{"u3", "u4"}, reviewers)
+	})
+
+	// Тест проверяет валидацию периода отсутствия.
+	// Ожидается: конец раньше начала — ошибка со статусом 400.
+	t.Run("AddAbsence - end before start", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/addAbsence", map[string]interface{}{
+			"user_id":    "u2",
+			"start_date": "2025-11-10",
+			"end_date":   "2025-11-01",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	// createPR создаёт PR автора u1 и возвращает назначенных ревьюеров.
+	createPR := func(t *testing.T) []interface{} {
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		reviewers := response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		require.Len(t, reviewers, 2)
+		return reviewers
+	}
+
+	addAbsence := func(t *testing.T, userID string, start time.Time) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/addAbsence", map[string]interface{}{
+			"user_id":    userID,
+			"start_date": start.Format("2006-01-02"),
+			"end_date":   time.Now().AddDate(0, 0, 7).Format("2006-01-02"),
+			"reason":     "vacation",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	newJob := func() *absence.ReassignAbsentReviewersUseCase {
+		prRepo := postgres.NewPRRepository(db)
+		clock := domain.SystemClock{}
+		reassignUseCase := pr.NewReassignReviewerUseCase(
+			postgres.NewTransactor(db), prRepo, postgres.NewUserRepository(db), postgres.NewTeamRepository(db), postgres.NewAssignmentHistoryRepository(db),
+			domain.NewReviewerAssigner(clock, domain.NewSystemRandomSource(), 24*time.Hour, domain.FairnessPolicy{}), clock,
+		)
+		return absence.NewReassignAbsentReviewersUseCase(postgres.NewAbsenceRepository(db), prRepo, reassignUseCase)
+	}
+
+	// Тест проверяет фоновое переназначение ревью пользователя, чьё отсутствие начинается сегодня.
+	// Ожидается: открытое ревью отсутствующего переходит к свободному участнику команды.
+	t.Run("ReassignAbsentReviewers - reassigns open reviews", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+		reviewers := createPR(t)
+		absent := reviewers[0].(string)
+		addAbsence(t, absent, time.Now())
+
+		results, err := newJob().Execute(time.Now())
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, absent, results[0].OldUserID)
+		assert.NotEqual(t, absent, results[0].ReplacedBy)
+		assert.NotEqual(t, reviewers[1], results[0].ReplacedBy)
+	})
+
+	// Тест проверяет период, начавшийся до запуска (пропущенный запуск или добавленный задним числом), и повторный запуск.
+	// Ожидается: ревью переназначено, период отмечен обработанным, второй запуск ничего не делает.
+	t.Run("ReassignAbsentReviewers - covers earlier start and is idempotent", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+		absent := createPR(t)[0].(string)
+		addAbsence(t, absent, time.Now().AddDate(0, 0, -2))
+
+		results, err := newJob().Execute(time.Now())
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, absent, results[0].OldUserID)
+
+		results, err = newJob().Execute(time.Now())
+		require.NoError(t, err)
+		assert.Empty(t, results)
+
+		// Два назначения при создании и одно переназначение
+		history, err := postgres.NewAssignmentHistoryRepository(db).GetByPRID("pr-1")
+		require.NoError(t, err)
+		assert.Len(t, history, 3)
+	})
+}
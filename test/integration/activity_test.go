@@ -0,0 +1,220 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_SetActivePolicies(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createTeam := func(t *testing.T, members ...map[string]interface{}) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members":   members,
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	member := func(userID string, isActive bool) map[string]interface{} {
+		return map[string]interface{}{"user_id": userID, "username": userID, "is_active": isActive}
+	}
+
+	createPR := func(t *testing.T) []interface{} {
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+	}
+
+	setActive := func(t *testing.T, body map[string]interface{}) []interface{} {
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setIsActive", body)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response["affected_prs"].([]interface{})
+	}
+
+	// Тест проверяет политику KEEP по умолчанию.
+	// Ожидается: ревью остаётся за пользователем и помечается устаревшим, при возвращении отметка снимается.
+	t.Run("SetActive - keep marks reviews stale", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, member("u1", true), member("u2", true), member("u3", true))
+		createPR(t)
+
+		affected := setActive(t, map[string]interface{}{"user_id": "u2", "is_active": false})
+		require.Len(t, affected, 1)
+		assert.Equal(t, "pr-1", affected[0].(map[string]interface{})["pull_request_id"])
+		assert.Equal(t, "MARKED_STALE", affected[0].(map[string]interface{})["action"])
+
+		affected = setActive(t, map[string]interface{}{"user_id": "u2", "is_active": true})
+		require.Len(t, affected, 1)
+		assert.Equal(t, "STALE_CLEARED", affected[0].(map[string]interface{})["action"])
+
+		setActive(t, map[string]interface{}{"user_id": "u3", "is_active": false})
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/merge", map[string]interface{}{
+			"pull_request_id": "pr-1",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		pr := response["pr"].(map[string]interface{})
+		assert.Len(t, pr["assigned_reviewers"], 2)
+		assert.Equal(t, []interface{}{"u3"}, pr["stale_reviewers"])
+	})
+
+	// Тест проверяет политику REASSIGN.
+	// Ожидается: ревью сразу переходит к свободному участнику команды.
+	t.Run("SetActive - reassign on deactivation", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, member("u1", true), member("u2", true), member("u3", true), member("u4", true))
+		reviewers := createPR(t)
+		deactivated := reviewers[0].(string)
+
+		affected := setActive(t, map[string]interface{}{
+			"user_id":       deactivated,
+			"is_active":     false,
+			"on_deactivate": "REASSIGN",
+		})
+		require.Len(t, affected, 1)
+		result := affected[0].(map[string]interface{})
+		assert.Equal(t, "REASSIGNED", result["action"])
+		assert.NotEqual(t, deactivated, result["replaced_by"])
+		assert.NotEqual(t, reviewers[1], result["replaced_by"])
+		assert.NotEqual(t, "u1", result["replaced_by"])
+	})
+
+	// Тест проверяет REASSIGN, когда заменить некем.
+	// Ожидается: ревью помечается устаревшим, в отчёте код NO_CANDIDATE.
+	t.Run("SetActive - reassign falls back to stale", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, member("u1", true), member("u2", true), member("u3", true))
+		createPR(t)
+
+		affected := setActive(t, map[string]interface{}{
+			"user_id":       "u2",
+			"is_active":     false,
+			"on_deactivate": "REASSIGN",
+		})
+		require.Len(t, affected, 1)
+		result := affected[0].(map[string]interface{})
+		assert.Equal(t, "MARKED_STALE", result["action"])
+		assert.Equal(t, "NO_CANDIDATE", result["error"])
+	})
+
+	// Тест проверяет политику BACKFILL при возвращении.
+	// Ожидается: вернувшийся пользователь добавлен на PR, где не хватало ревьюеров.
+	t.Run("SetActive - backfill on reactivation", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, member("u1", true), member("u2", true), member("u3", false))
+		require.Equal(t, []interface{}{"u2"}, createPR(t))
+
+		affected := setActive(t, map[string]interface{}{
+			"user_id":       "u3",
+			"is_active":     true,
+			"on_reactivate": "BACKFILL",
+		})
+		require.Len(t, affected, 1)
+		result := affected[0].(map[string]interface{})
+		assert.Equal(t, "BACKFILLED", result["action"])
+		assert.Equal(t, []interface{}{"u3"}, result["added_reviewers"])
+	})
+
+	// Тест проверяет, что BACKFILL при возвращении назначает только вернувшегося пользователя.
+	// Ожидается: на PR без ревьюеров добавлен он один, хотя свободен и другой участник команды.
+	t.Run("SetActive - backfill adds only the reactivated user", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, member("u1", true), member("u2", false), member("u3", false))
+		require.Empty(t, createPR(t))
+		setActive(t, map[string]interface{}{"user_id": "u2", "is_active": true})
+
+		affected := setActive(t, map[string]interface{}{
+			"user_id":       "u3",
+			"is_active":     true,
+			"on_reactivate": "BACKFILL",
+		})
+		require.Len(t, affected, 1)
+		assert.Equal(t, []interface{}{"u3"}, affected[0].(map[string]interface{})["added_reviewers"])
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, []interface{}{"u3"}, response["pr"].(map[string]interface{})["assigned_reviewers"])
+	})
+
+	// Тест проверяет, что BACKFILL при возвращении не выходит за команду пользователя.
+	// Ожидается: PR другой команды, которому не хватает ревьюера, не изменён и не попал в отчёт.
+	t.Run("SetActive - backfill is scoped to user's team", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, member("u1", true), member("u2", true), member("u3", false))
+		require.Equal(t, []interface{}{"u2"}, createPR(t))
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "frontend",
+			"members":   []interface{}{member("f1", true), member("f2", true), member("f3", false)},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-2",
+			"pull_request_name": "Frontend PR",
+			"author_id":         "f1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+		// f3 возвращается без добора, так что у pr-2 остаётся свободный кандидат
+		setActive(t, map[string]interface{}{"user_id": "f3", "is_active": true})
+
+		affected := setActive(t, map[string]interface{}{
+			"user_id":       "u3",
+			"is_active":     true,
+			"on_reactivate": "BACKFILL",
+		})
+		require.Len(t, affected, 1)
+		assert.Equal(t, "pr-1", affected[0].(map[string]interface{})["pull_request_id"])
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-2", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, []interface{}{"f2"}, response["pr"].(map[string]interface{})["assigned_reviewers"])
+	})
+
+	// Тест проверяет валидацию политики.
+	// Ожидается: неизвестная политика — 400, активность не меняется.
+	t.Run("SetActive - invalid policy", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, member("u1", true))
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setIsActive", map[string]interface{}{
+			"user_id":       "u1",
+			"is_active":     false,
+			"on_deactivate": "DELETE",
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/team/get?team_name=backend", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		team := response["team"].(map[string]interface{})
+		assert.Equal(t, true, team["members"].([]interface{})[0].(map[string]interface{})["is_active"])
+	})
+}
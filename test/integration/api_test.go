@@ -53,7 +53,7 @@ func TestAPI_TeamEndpoints(t *testing.T) {
 	})
 
 	// Тест проверяет обработку попытки создать команду с уже существующим именем.
-	// Ожидается: возвращается ошибка TEAM_EXISTS со статусом 400.
+	// Ожидается: возвращается ошибка TEAM_EXISTS со статусом 409.
 	t.Run("CreateTeam - duplicate team", func(t *testing.T) {
 		helpers.CleanupDB(db)
 
@@ -75,7 +75,7 @@ func TestAPI_TeamEndpoints(t *testing.T) {
 		w2 := httptest.NewRecorder()
 		router.ServeHTTP(w2, req2)
 
-		assert.Equal(t, http.StatusBadRequest, w2.Code)
+		assert.Equal(t, http.StatusConflict, w2.Code)
 		var errorResp map[string]interface{}
 		json.Unmarshal(w2.Body.Bytes(), &errorResp)
 		assert.Equal(t, "TEAM_EXISTS", errorResp["error"].(map[string]interface{})["code"])
@@ -104,8 +104,9 @@ func TestAPI_TeamEndpoints(t *testing.T) {
 		router.ServeHTTP(w2, req2)
 
 		assert.Equal(t, http.StatusOK, w2.Code)
-		var team map[string]interface{}
-		json.Unmarshal(w2.Body.Bytes(), &team)
+		var response map[string]interface{}
+		json.Unmarshal(w2.Body.Bytes(), &response)
+		team := response["team"].(map[string]interface{})
 		assert.Equal(t, "frontend", team["team_name"])
 	})
 
@@ -0,0 +1,197 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPIv2(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createTeam := func(t *testing.T, teamName string, members []map[string]interface{}) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/api/v2/teams", map[string]interface{}{
+			"team_name": teamName,
+			"members":   members,
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	setup := func(t *testing.T) {
+		createTeam(t, "backend", []map[string]interface{}{
+			{"user_id": "u1", "username": "Alice", "is_active": true},
+			{"user_id": "u2", "username": "Bob", "is_active": true},
+			{"user_id": "u3", "username": "Charlie", "is_active": true},
+			{"user_id": "u4", "username": "Dave", "is_active": true},
+		})
+	}
+
+	// Тест проверяет создание команды через POST /api/v2/teams
+	// Ожидается: 201, команда в теле без обёртки и её адрес в заголовке Location
+	t.Run("Create team", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/api/v2/teams", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "/api/v2/teams/backend", w.Header().Get("Location"))
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "backend", response["team_name"])
+		assert.Len(t, response["members"], 1)
+	})
+
+	// Тест проверяет список команд и получение команды по адресу
+	// Ожидается: команды отсортированы по имени, GET по адресу из Location возвращает команду, неизвестная — 404
+	t.Run("List and get teams", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, "payments", []map[string]interface{}{{"user_id": "p1", "username": "Eve", "is_active": true}})
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/api/v2/teams", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var list map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &list)
+		teams := list["teams"].([]interface{})
+		require.Len(t, teams, 2)
+		assert.Equal(t, "backend", teams[0].(map[string]interface{})["team_name"])
+		assert.Len(t, teams[0].(map[string]interface{})["members"], 4)
+		assert.Equal(t, "payments", teams[1].(map[string]interface{})["team_name"])
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/api/v2/teams/payments", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var team map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &team)
+		assert.Equal(t, "payments", team["team_name"])
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/api/v2/teams/unknown", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	// Тест проверяет частичное обновление пользователя через PATCH
+	// Ожидается: меняются только переданные поля, пустое тело и неизвестный уровень — 400, неизвестный пользователь — 404
+	t.Run("Patch user", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPatch, "/api/v2/users/u2", map[string]interface{}{
+			"seniority": "senior",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		user := response["user"].(map[string]interface{})
+		assert.Equal(t, "senior", user["seniority"])
+		assert.Equal(t, true, user["is_active"])
+
+		w = helpers.PerformRequest(router, http.MethodPatch, "/api/v2/users/u2", map[string]interface{}{
+			"is_active": false,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		json.Unmarshal(w.Body.Bytes(), &response)
+		user = response["user"].(map[string]interface{})
+		assert.Equal(t, "senior", user["seniority"])
+		assert.Equal(t, false, user["is_active"])
+
+		w = helpers.PerformRequest(router, http.MethodPatch, "/api/v2/users/u2", map[string]interface{}{})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPatch, "/api/v2/users/u2", map[string]interface{}{"seniority": "guru"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPatch, "/api/v2/users/unknown", map[string]interface{}{"is_active": true})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	// Тест проверяет получение PR и переназначение ревьюера через действие :reassign
+	// Ожидается: PR отдаётся без обёртки, ревьюер заменяется на выбранного, неизвестное действие — 404
+	t.Run("Get PR and reassign reviewer", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Feature",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/api/v2/pull-requests/pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var pr map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &pr)
+		assert.Equal(t, "pr-1", pr["pull_request_id"])
+		reviewers := pr["assigned_reviewers"].([]interface{})
+		require.Len(t, reviewers, 2)
+
+		// Свободный участник — тот из u2, u3, u4, кто не назначен
+		assigned := map[string]bool{reviewers[0].(string): true, reviewers[1].(string): true}
+		var free string
+		for _, userID := range []string{"u2", "u3", "u4"} {
+			if !assigned[userID] {
+				free = userID
+			}
+		}
+
+		oldReviewer := reviewers[0].(string)
+		w = helpers.PerformRequest(router, http.MethodPost, "/api/v2/pull-requests/pr-1/reviewers/"+oldReviewer+":reassign", map[string]interface{}{
+			"new_user_id": free,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, free, response["replaced_by"])
+		assert.NotContains(t, response["pr"].(map[string]interface{})["assigned_reviewers"], oldReviewer)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/api/v2/pull-requests/pr-1/reviewers/"+free+":approve", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/api/v2/pull-requests/unknown", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	// Тест проверяет переназначение без тела запроса
+	// Ожидается: замена выбирается автоматически
+	t.Run("Reassign without body", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Feature",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var created map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &created)
+		oldReviewer := created["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})[0].(string)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/api/v2/pull-requests/pr-1/reviewers/"+oldReviewer+":reassign", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NotEqual(t, oldReviewer, response["replaced_by"])
+	})
+}
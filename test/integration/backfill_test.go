@@ -0,0 +1,237 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_BackfillReviewers(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	// createUnderstaffedPR создаёт PR в команде, где у автора один активный
+	// коллега, поэтому на PR назначается только он.
+	createUnderstaffedPR := func(t *testing.T, prID string) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   prID,
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		require.Equal(t, []interface{}{"u2"}, response["pr"].(map[string]interface{})["assigned_reviewers"])
+	}
+
+	setup := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": false},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	backfill := func(t *testing.T) map[string]interface{} {
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/backfill", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response
+	}
+
+	// Тест проверяет добор ревьюера после возвращения участника команды.
+	// Ожидается: u3 добавлен на PR, в отчёте missing = 0, в истории появилось назначение.
+	t.Run("Backfill - tops up after reactivation", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+		createUnderstaffedPR(t, "pr-1")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setIsActive", map[string]interface{}{
+			"user_id":   "u3",
+			"is_active": true,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		report := backfill(t)
+		assert.Equal(t, float64(1), report["scanned"])
+
+		results := report["results"].([]interface{})
+		require.Len(t, results, 1)
+		result := results[0].(map[string]interface{})
+		assert.Equal(t, "pr-1", result["pull_request_id"])
+		assert.Equal(t, []interface{}{"u3"}, result["added_reviewers"])
+		assert.Equal(t, float64(0), result["missing"])
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/users/getReview?user_id=u3", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "pr-1")
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getHistory?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var history map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &history)
+		assert.Len(t, history["history"], 2)
+
+		// Повторный запуск ничего не меняет
+		report = backfill(t)
+		assert.Empty(t, report["results"])
+	})
+
+	// Тест проверяет отчёт, когда добрать некого.
+	// Ожидается: PR попадает в отчёт с пустым added_reviewers и missing = 1.
+	t.Run("Backfill - no candidates", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+		createUnderstaffedPR(t, "pr-1")
+
+		results := backfill(t)["results"].([]interface{})
+		require.Len(t, results, 1)
+		result := results[0].(map[string]interface{})
+		assert.Empty(t, result["added_reviewers"])
+		assert.Equal(t, float64(1), result["missing"])
+	})
+
+	// Тест проверяет, что слитые PR не добираются.
+	// Ожидается: после merge PR не сканируется и не меняется.
+	t.Run("Backfill - skips merged PRs", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+		createUnderstaffedPR(t, "pr-1")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/merge", map[string]interface{}{
+			"pull_request_id": "pr-1",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/users/setIsActive", map[string]interface{}{
+			"user_id":   "u3",
+			"is_active": true,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		report := backfill(t)
+		assert.Equal(t, float64(0), report["scanned"])
+		assert.Empty(t, report["results"])
+	})
+
+	// Тест проверяет добор из запасной команды.
+	// Ожидается: недостающий ревьюер берётся из запасной команды и отмечен в fallback_reviewers.
+	t.Run("Backfill - uses fallback teams", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+		createUnderstaffedPR(t, "pr-1")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "platform",
+			"members": []map[string]interface{}{
+				{"user_id": "p1", "username": "Peter", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/setFallbackTeams", map[string]interface{}{
+			"team_name":      "backend",
+			"fallback_teams": []string{"platform"},
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		results := backfill(t)["results"].([]interface{})
+		require.Len(t, results, 1)
+		result := results[0].(map[string]interface{})
+		assert.Equal(t, []interface{}{"p1"}, result["added_reviewers"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"user_id": "p1", "team_name": "platform"},
+		}, result["fallback_reviewers"])
+	})
+	// Тест проверяет, что добор идёт до цели команды, а не до общего значения по умолчанию.
+	// Ожидается: при reviewers_target = 3 вернувшийся u3 добавлен, но одного ревьюера всё ещё не хватает.
+	t.Run("Backfill - uses team reviewers target", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+		createUnderstaffedPR(t, "pr-1")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setReviewersTarget", map[string]interface{}{
+			"team_name":        "backend",
+			"reviewers_target": 3,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, float64(3), response["team"].(map[string]interface{})["reviewers_target"])
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/users/setIsActive", map[string]interface{}{
+			"user_id":   "u3",
+			"is_active": true,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		results := backfill(t)["results"].([]interface{})
+		require.Len(t, results, 1)
+		result := results[0].(map[string]interface{})
+		assert.Equal(t, []interface{}{"u3"}, result["added_reviewers"])
+		assert.Equal(t, float64(1), result["missing"])
+	})
+
+	// Тест проверяет валидацию цели команды.
+	// Ожидается: цель больше 10 — 400.
+	t.Run("SetReviewersTarget - out of range", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setReviewersTarget", map[string]interface{}{
+			"team_name":        "backend",
+			"reviewers_target": 11,
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	// Тест проверяет, что добор соблюдает правило старшинства команды.
+	// Ожидается: пока правило не выполнено, добавляется senior u4, а не вернувшийся раньше него junior u3.
+	t.Run("Backfill - applies seniority rule", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true, "seniority": "junior"},
+				{"user_id": "u3", "username": "Charlie", "is_active": false, "seniority": "junior"},
+				{"user_id": "u4", "username": "Dave", "is_active": false, "seniority": "senior"},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+		createUnderstaffedPR(t, "pr-1")
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/setSeniorityRule", map[string]interface{}{
+			"team_name":     "backend",
+			"min_reviewers": 1,
+			"min_level":     "senior",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+		for _, userID := range []string{"u3", "u4"} {
+			w = helpers.PerformRequest(router, http.MethodPost, "/users/setIsActive", map[string]interface{}{
+				"user_id":   userID,
+				"is_active": true,
+			})
+			require.Equal(t, http.StatusOK, w.Code)
+		}
+
+		results := backfill(t)["results"].([]interface{})
+		require.Len(t, results, 1)
+		assert.Equal(t, []interface{}{"u4"}, results[0].(map[string]interface{})["added_reviewers"])
+	})
+}
@@ -0,0 +1,221 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_ConditionalRequests(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	setup := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "Dave", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Feature",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	get := func(path string, headers map[string]string) (int, string) {
+		w := helpers.PerformRequestWithHeaders(router, http.MethodGet, path, nil, headers)
+		return w.Code, w.Header().Get("ETag")
+	}
+
+	errorCode := func(body []byte) string {
+		var response map[string]interface{}
+		json.Unmarshal(body, &response)
+		return response["error"].(map[string]interface{})["code"].(string)
+	}
+
+	// Тест проверяет ETag и Last-Modified на чтении команды
+	// Ожидается: совпавший If-None-Match даёт 304 без тела, в том числе в списке и со слабым ETag
+	t.Run("Team not modified", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/team/get?team_name=backend", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		etag := w.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+		assert.NotEmpty(t, w.Header().Get("Last-Modified"))
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodGet, "/team/get?team_name=backend", nil,
+			map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.Bytes())
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+
+		code, _ := get("/api/v2/teams/backend", map[string]string{"If-None-Match": `"999", W/` + etag})
+		assert.Equal(t, http.StatusNotModified, code)
+
+		code, _ = get("/api/v2/teams/backend", map[string]string{"If-None-Match": `"999"`})
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	// Тест проверяет, что изменение участника меняет ETag команды
+	// Ожидается: после setIsActive прежний ETag команды и пользователя больше не даёт 304
+	t.Run("Member change invalidates team", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		_, teamETag := get("/team/get?team_name=backend", nil)
+		_, userETag := get("/api/v2/users/u4", nil)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setIsActive", map[string]interface{}{
+			"user_id":   "u4",
+			"is_active": false,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		code, newTeamETag := get("/team/get?team_name=backend", map[string]string{"If-None-Match": teamETag})
+		assert.Equal(t, http.StatusOK, code)
+		assert.NotEqual(t, teamETag, newTeamETag)
+
+		code, newUserETag := get("/api/v2/users/u4", map[string]string{"If-None-Match": userETag})
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, w.Header().Get("ETag"), newUserETag)
+	})
+
+	// Тест проверяет If-Modified-Since на чтении PR
+	// Ожидается: 304, если PR не менялся с указанного времени; без If-None-Match
+	t.Run("PR not modified since", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		lastModified := w.Header().Get("Last-Modified")
+		require.NotEmpty(t, lastModified)
+
+		code, _ := get("/api/v2/pull-requests/pr-1", map[string]string{"If-Modified-Since": lastModified})
+		assert.Equal(t, http.StatusNotModified, code)
+
+		code, _ = get("/api/v2/pull-requests/pr-1", map[string]string{"If-Modified-Since": "Mon, 01 Jan 2001 00:00:00 GMT"})
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	// Тест проверяет запись с If-Match
+	// Ожидается: устаревшая версия — 412 PRECONDITION_FAILED без изменений, текущая — 200 с новым ETag
+	t.Run("PR write with If-Match", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		_, etag := get("/pullRequest/get?pull_request_id=pr-1", nil)
+
+		w := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/addReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         "u4",
+		}, map[string]string{"If-Match": `"999"`})
+		require.Equal(t, http.StatusPreconditionFailed, w.Code)
+		assert.Equal(t, "PRECONDITION_FAILED", errorCode(w.Body.Bytes()))
+
+		code, unchanged := get("/pullRequest/get?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, etag, unchanged)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/merge", map[string]interface{}{
+			"pull_request_id": "pr-1",
+		}, map[string]string{"If-Match": etag})
+		require.Equal(t, http.StatusOK, w.Code)
+		merged := w.Header().Get("ETag")
+		assert.NotEqual(t, etag, merged)
+
+		_, current := get("/pullRequest/get?pull_request_id=pr-1", nil)
+		assert.Equal(t, merged, current)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/merge", map[string]interface{}{
+			"pull_request_id": "pr-1",
+		}, map[string]string{"If-Match": etag})
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+
+	// Тест проверяет If-Match на командах и пользователях
+	// Ожидается: * и текущая версия принимаются, устаревшая — 412, некорректный заголовок — 400
+	t.Run("Team and user writes with If-Match", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		_, teamETag := get("/team/get?team_name=backend", nil)
+
+		w := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/team/setSLA", map[string]interface{}{
+			"team_name":  "backend",
+			"review_sla": "24h",
+			"action":     "NOTIFY",
+		}, map[string]string{"If-Match": teamETag})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/team/setSLA", map[string]interface{}{
+			"team_name":  "backend",
+			"review_sla": "",
+		}, map[string]string{"If-Match": teamETag})
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+		_, userETag := get("/api/v2/users/u2", nil)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPatch, "/api/v2/users/u2", map[string]interface{}{
+			"seniority": "senior",
+		}, map[string]string{"If-Match": userETag})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/users/setTags", map[string]interface{}{
+			"user_id": "u2",
+			"tags":    []string{"go"},
+		}, map[string]string{"If-Match": userETag})
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/users/setTags", map[string]interface{}{
+			"user_id": "u2",
+			"tags":    []string{"go"},
+		}, map[string]string{"If-Match": "*"})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		for _, header := range []string{"1", `W/"1"`, `"1", "2"`} {
+			w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/users/setTags", map[string]interface{}{
+				"user_id": "u2",
+				"tags":    []string{"go"},
+			}, map[string]string{"If-Match": header})
+			assert.Equal(t, http.StatusBadRequest, w.Code, header)
+		}
+	})
+
+	// Тест проверяет получение пользователя в v2
+	// Ожидается: пользователь с ETag, неизвестный пользователь — 404
+	t.Run("Get user v2", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/api/v2/users/u1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "u1", response["user_id"])
+		assert.Equal(t, "backend", response["team_name"])
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/api/v2/users/unknown", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
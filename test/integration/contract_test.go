@@ -0,0 +1,348 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+// TestContract_Specifications не требует базы: спецификации должны загружаться
+// и проходить проверку вместе с примерами.
+func TestContract_Specifications(t *testing.T) {
+	for _, path := range []string{"../../openapi.yml", "../../openapi-v2.yml"} {
+		_, err := helpers.LoadContract(http.NotFoundHandler(), path)
+		assert.NoError(t, err)
+	}
+}
+
+func TestAPI_Contract(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	v1, err := helpers.LoadContract(router, "../../openapi.yml")
+	require.NoError(t, err)
+	v2, err := helpers.LoadContract(router, "../../openapi-v2.yml")
+	require.NoError(t, err)
+
+	call := func(t *testing.T, contract *helpers.Contract, status int, method, path string, body interface{}, headers map[string]string) (map[string]interface{}, http.Header) {
+		t.Helper()
+		w, err := contract.Perform(method, path, body, headers)
+		require.NoError(t, err)
+		require.Equal(t, status, w.Code, w.Body.String())
+
+		var response map[string]interface{}
+		if strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return response, w.Header()
+	}
+
+	members := func(ids ...string) []map[string]interface{} {
+		result := make([]map[string]interface{}, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, map[string]interface{}{"user_id": id, "username": "User " + id, "is_active": true})
+		}
+		return result
+	}
+
+	setup := func(t *testing.T) {
+		call(t, v1, http.StatusCreated, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members":   members("u1", "u2", "u3", "u4", "u5"),
+		}, nil)
+		call(t, v1, http.StatusCreated, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "frontend",
+			"members":   members("f1", "f2"),
+		}, nil)
+	}
+
+	// reviewersOf возвращает назначенных ревьюеров PR и свободных участников backend.
+	reviewersOf := func(pr map[string]interface{}) ([]string, []string) {
+		assigned := map[string]bool{}
+		var reviewers []string
+		for _, id := range pr["assigned_reviewers"].([]interface{}) {
+			reviewers = append(reviewers, id.(string))
+			assigned[id.(string)] = true
+		}
+		var free []string
+		for _, id := range []string{"u2", "u3", "u4", "u5"} {
+			if !assigned[id] {
+				free = append(free, id)
+			}
+		}
+		return reviewers, free
+	}
+
+	// Тест проверяет операции с командами по openapi.yml
+	// Ожидается: ответы, включая 304, 400, 404, 409 и 412, соответствуют схеме
+	t.Run("Teams", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		response, _ := call(t, v1, http.StatusConflict, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members":   members("u1"),
+		}, nil)
+		assert.Equal(t, "TEAM_EXISTS", response["error"].(map[string]interface{})["code"])
+		call(t, v1, http.StatusBadRequest, http.MethodPost, "/team/add", map[string]interface{}{"team_name": "qa"}, nil)
+
+		response, header := call(t, v1, http.StatusOK, http.MethodGet, "/team/get?team_name=backend", nil, nil)
+		assert.Equal(t, "backend", response["team"].(map[string]interface{})["team_name"])
+		call(t, v1, http.StatusNotModified, http.MethodGet, "/team/get?team_name=backend", nil,
+			map[string]string{"If-None-Match": header.Get("ETag")})
+		call(t, v1, http.StatusNotFound, http.MethodGet, "/team/get?team_name=unknown", nil, nil)
+		call(t, v1, http.StatusBadRequest, http.MethodGet, "/team/get", nil, nil)
+
+		call(t, v1, http.StatusOK, http.MethodPost, "/team/setSeniorityRule", map[string]interface{}{
+			"team_name": "backend", "min_reviewers": 0, "min_level": "senior",
+		}, nil)
+		call(t, v1, http.StatusNotFound, http.MethodPost, "/team/setSeniorityRule", map[string]interface{}{
+			"team_name": "unknown", "min_reviewers": 0,
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/team/setSLA", map[string]interface{}{
+			"team_name": "backend", "review_sla": "24h", "action": "NOTIFY",
+		}, nil)
+		call(t, v1, http.StatusBadRequest, http.MethodPost, "/team/setSLA", map[string]interface{}{
+			"team_name": "backend", "review_sla": "soon", "action": "NOTIFY",
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/team/setPairingRules", map[string]interface{}{
+			"team_name": "backend",
+			"rules": []map[string]interface{}{
+				{"type": "PREFER_PAIR", "author_id": "u1", "reviewer_id": "u2"},
+			},
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/team/explainPairing", map[string]interface{}{"author_id": "u1"}, nil)
+		call(t, v1, http.StatusNotFound, http.MethodPost, "/team/explainPairing", map[string]interface{}{"author_id": "u404"}, nil)
+		call(t, v1, http.StatusPreconditionFailed, http.MethodPost, "/team/setFallbackTeams", map[string]interface{}{
+			"team_name": "backend", "fallback_teams": []string{"frontend"},
+		}, map[string]string{"If-Match": `"999"`})
+		call(t, v1, http.StatusOK, http.MethodPost, "/team/setFallbackTeams", map[string]interface{}{
+			"team_name": "backend", "fallback_teams": []string{"frontend"},
+		}, nil)
+	})
+
+	// Тест проверяет операции с пользователями по openapi.yml
+	// Ожидается: ответы, включая 204, 400 и 404, соответствуют схеме
+	t.Run("Users", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		call(t, v1, http.StatusOK, http.MethodPost, "/users/setIsActive", map[string]interface{}{
+			"user_id": "u5", "is_active": false,
+		}, nil)
+		call(t, v1, http.StatusNotFound, http.MethodPost, "/users/setIsActive", map[string]interface{}{
+			"user_id": "u404", "is_active": false,
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodGet, "/users/getReview?user_id=u2", nil, nil)
+		call(t, v1, http.StatusNotFound, http.MethodGet, "/users/getReview?user_id=u404", nil, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/users/setSeniority", map[string]interface{}{
+			"user_id": "u2", "seniority": "senior",
+		}, nil)
+		call(t, v1, http.StatusBadRequest, http.MethodPost, "/users/setSeniority", map[string]interface{}{
+			"user_id": "u2", "seniority": "guru",
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/users/setSchedule", map[string]interface{}{
+			"user_id": "u2", "time_zone": "Europe/Moscow", "work_start": "09:00", "work_end": "18:00",
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/users/setNotifications", map[string]interface{}{
+			"user_id": "u2", "email": "bob@example.com", "frequency": "DAILY",
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodGet, "/users/getNotifications?user_id=u2", nil, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/users/setTags", map[string]interface{}{
+			"user_id": "u2", "tags": []string{"go", "sql"},
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodGet, "/users/getTags?user_id=u2", nil, nil)
+		call(t, v1, http.StatusBadRequest, http.MethodGet, "/users/getTags", nil, nil)
+
+		start := time.Now().AddDate(0, 0, 7).Format("2006-01-02")
+		end := time.Now().AddDate(0, 0, 14).Format("2006-01-02")
+		response, _ := call(t, v1, http.StatusCreated, http.MethodPost, "/users/addAbsence", map[string]interface{}{
+			"user_id": "u3", "start_date": start, "end_date": end, "reason": "vacation",
+		}, nil)
+		absenceID := response["absence"].(map[string]interface{})["absence_id"]
+		call(t, v1, http.StatusBadRequest, http.MethodPost, "/users/addAbsence", map[string]interface{}{
+			"user_id": "u3", "start_date": end, "end_date": start,
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodGet, "/users/getAbsences?user_id=u3", nil, nil)
+		call(t, v1, http.StatusNoContent, http.MethodPost, "/users/deleteAbsence", map[string]interface{}{"absence_id": absenceID}, nil)
+		call(t, v1, http.StatusNotFound, http.MethodPost, "/users/deleteAbsence", map[string]interface{}{"absence_id": absenceID}, nil)
+	})
+
+	// Тест проверяет операции с PR по openapi.yml
+	// Ожидается: ответы, включая 304, 400, 404, 409 и 412, соответствуют схеме
+	t.Run("Pull requests", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		create := map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Add search",
+			"author_id":         "u1",
+			"changed_files":     []string{"internal/search/index.go"},
+			"labels":            []string{"feature"},
+		}
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/preview", map[string]interface{}{"author_id": "u1"}, nil)
+		call(t, v1, http.StatusNotFound, http.MethodPost, "/pullRequest/preview", map[string]interface{}{"author_id": "u404"}, nil)
+		response, _ := call(t, v1, http.StatusCreated, http.MethodPost, "/pullRequest/create", create, nil)
+		reviewers, free := reviewersOf(response["pr"].(map[string]interface{}))
+		require.Len(t, reviewers, 2)
+		call(t, v1, http.StatusConflict, http.MethodPost, "/pullRequest/create", create, nil)
+		call(t, v1, http.StatusBadRequest, http.MethodPost, "/pullRequest/create", map[string]interface{}{"pull_request_id": "pr-2"}, nil)
+
+		_, header := call(t, v1, http.StatusOK, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil, nil)
+		call(t, v1, http.StatusNotModified, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil,
+			map[string]string{"If-None-Match": header.Get("ETag")})
+		call(t, v1, http.StatusNotFound, http.MethodGet, "/pullRequest/get?pull_request_id=pr-404", nil, nil)
+		call(t, v1, http.StatusOK, http.MethodGet, "/pullRequest/list?author_id=u1&status=OPEN&limit=10", nil, nil)
+		call(t, v1, http.StatusBadRequest, http.MethodGet, "/pullRequest/list?limit=-1", nil, nil)
+
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/addReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1", "user_id": free[0],
+		}, nil)
+		call(t, v1, http.StatusConflict, http.MethodPost, "/pullRequest/addReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1", "user_id": free[0],
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/removeReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1", "user_id": free[0],
+		}, nil)
+		call(t, v1, http.StatusPreconditionFailed, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1", "old_user_id": reviewers[0],
+		}, map[string]string{"If-Match": `"999"`})
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1", "old_user_id": reviewers[0],
+		}, nil)
+		call(t, v1, http.StatusConflict, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1", "old_user_id": "u1",
+		}, nil)
+
+		call(t, v1, http.StatusOK, http.MethodGet, "/pullRequest/getHistory?pull_request_id=pr-1", nil, nil)
+		call(t, v1, http.StatusOK, http.MethodGet, "/pullRequest/getReasoning?pull_request_id=pr-1", nil, nil)
+		call(t, v1, http.StatusOK, http.MethodGet, "/pullRequest/getEscalations?pull_request_id=pr-1", nil, nil)
+		call(t, v1, http.StatusNotFound, http.MethodGet, "/pullRequest/getHistory?pull_request_id=pr-404", nil, nil)
+
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-1"}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-1"}, nil)
+		call(t, v1, http.StatusNotFound, http.MethodPost, "/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-404"}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/backfill", nil, nil)
+
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/batchCreate", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-2", "pull_request_name": "Two", "author_id": "u1"},
+				{"pull_request_id": "pr-3", "pull_request_name": "Unknown", "author_id": "u404"},
+			},
+		}, nil)
+		response, _ = call(t, v1, http.StatusOK, http.MethodGet, "/pullRequest/get?pull_request_id=pr-2", nil, nil)
+		reviewers, _ = reviewersOf(response["pr"].(map[string]interface{}))
+		require.NotEmpty(t, reviewers)
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/batchReassign", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-2", "old_user_id": reviewers[0]},
+				{"pull_request_id": "pr-404", "old_user_id": "u2"},
+			},
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/pullRequest/batchMerge", map[string]interface{}{
+			"mode":  "ALL_OR_NOTHING",
+			"items": []map[string]interface{}{{"pull_request_id": "pr-2"}},
+		}, nil)
+		call(t, v1, http.StatusBadRequest, http.MethodPost, "/pullRequest/batchMerge", map[string]interface{}{
+			"items": []map[string]interface{}{},
+		}, nil)
+	})
+
+	// Тест проверяет владение кодом, GraphQL и проверку здоровья по openapi.yml
+	// Ожидается: ответы соответствуют схеме, в том числе text/plain у /health
+	t.Run("Ownership, GraphQL and health", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		call(t, v1, http.StatusOK, http.MethodPost, "/ownership/setRules", map[string]interface{}{
+			"rules": []map[string]interface{}{
+				{"pattern": "*.go", "team_name": "backend"},
+				{"pattern": "/web/", "user_ids": []string{"f1"}},
+			},
+		}, nil)
+		call(t, v1, http.StatusNotFound, http.MethodPost, "/ownership/setRules", map[string]interface{}{
+			"rules": []map[string]interface{}{{"pattern": "*.go", "team_name": "unknown"}},
+		}, nil)
+		call(t, v1, http.StatusOK, http.MethodGet, "/ownership/getRules", nil, nil)
+		call(t, v1, http.StatusOK, http.MethodPost, "/ownership/explain", map[string]interface{}{
+			"changed_files": []string{"main.go", "README.md"},
+		}, nil)
+
+		query := `query($name: String!) { team(team_name: $name) { team_name members { user_id } } }`
+		response, _ := call(t, v1, http.StatusOK, http.MethodPost, "/graphql", map[string]interface{}{
+			"query":     query,
+			"variables": map[string]interface{}{"name": "backend"},
+		}, nil)
+		assert.NotNil(t, response["data"])
+		call(t, v1, http.StatusOK, http.MethodPost, "/graphql", map[string]interface{}{
+			"query":     query,
+			"variables": map[string]interface{}{"name": "unknown"},
+		}, nil)
+		call(t, v1, http.StatusBadRequest, http.MethodPost, "/graphql", map[string]interface{}{}, nil)
+
+		call(t, v1, http.StatusOK, http.MethodGet, "/health", nil, nil)
+	})
+
+	// Тест проверяет операции API v2 по openapi-v2.yml
+	// Ожидается: ответы, включая 304, 400, 404, 409 и 412, соответствуют схеме
+	t.Run("API v2", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		call(t, v2, http.StatusCreated, http.MethodPost, "/api/v2/teams", map[string]interface{}{
+			"team_name": "backend",
+			"members":   members("u1", "u2", "u3", "u4"),
+		}, nil)
+		call(t, v2, http.StatusConflict, http.MethodPost, "/api/v2/teams", map[string]interface{}{
+			"team_name": "backend",
+			"members":   members("u1"),
+		}, nil)
+		call(t, v2, http.StatusBadRequest, http.MethodPost, "/api/v2/teams", map[string]interface{}{"team_name": "qa"}, nil)
+		call(t, v2, http.StatusOK, http.MethodGet, "/api/v2/teams", nil, nil)
+		_, header := call(t, v2, http.StatusOK, http.MethodGet, "/api/v2/teams/backend", nil, nil)
+		call(t, v2, http.StatusNotModified, http.MethodGet, "/api/v2/teams/backend", nil,
+			map[string]string{"If-None-Match": header.Get("ETag")})
+		call(t, v2, http.StatusNotFound, http.MethodGet, "/api/v2/teams/unknown", nil, nil)
+
+		_, header = call(t, v2, http.StatusOK, http.MethodGet, "/api/v2/users/u4", nil, nil)
+		call(t, v2, http.StatusNotModified, http.MethodGet, "/api/v2/users/u4", nil,
+			map[string]string{"If-None-Match": header.Get("ETag")})
+		call(t, v2, http.StatusNotFound, http.MethodGet, "/api/v2/users/u404", nil, nil)
+		call(t, v2, http.StatusOK, http.MethodPatch, "/api/v2/users/u4", map[string]interface{}{"seniority": "lead"}, nil)
+		call(t, v2, http.StatusBadRequest, http.MethodPatch, "/api/v2/users/u4", map[string]interface{}{"seniority": "guru"}, nil)
+		call(t, v2, http.StatusPreconditionFailed, http.MethodPatch, "/api/v2/users/u4", map[string]interface{}{"is_active": true},
+			map[string]string{"If-Match": `"999"`})
+
+		response, _ := call(t, v1, http.StatusCreated, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id": "pr-1", "pull_request_name": "Add search", "author_id": "u1",
+		}, nil)
+		reviewers, _ := reviewersOf(response["pr"].(map[string]interface{}))
+		require.NotEmpty(t, reviewers)
+
+		_, header = call(t, v2, http.StatusOK, http.MethodGet, "/api/v2/pull-requests/pr-1", nil, nil)
+		call(t, v2, http.StatusNotModified, http.MethodGet, "/api/v2/pull-requests/pr-1", nil,
+			map[string]string{"If-None-Match": header.Get("ETag")})
+		call(t, v2, http.StatusNotFound, http.MethodGet, "/api/v2/pull-requests/pr-404", nil, nil)
+		call(t, v2, http.StatusOK, http.MethodPost, fmt.Sprintf("/api/v2/pull-requests/pr-1/reviewers/%s:reassign", reviewers[0]), nil, nil)
+		call(t, v2, http.StatusConflict, http.MethodPost, "/api/v2/pull-requests/pr-1/reviewers/u1:reassign", nil, nil)
+		call(t, v2, http.StatusNotFound, http.MethodPost, "/api/v2/pull-requests/pr-404/reviewers/u2:reassign", nil, nil)
+	})
+
+	// Тест проверяет, что вызваны все операции обеих спецификаций
+	// Ожидается: непокрытых операций нет
+	t.Run("Coverage", func(t *testing.T) {
+		assert.Empty(t, v1.Uncovered(), "openapi.yml")
+		assert.Empty(t, v2.Uncovered(), "openapi-v2.yml")
+	})
+}
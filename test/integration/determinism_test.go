@@ -0,0 +1,96 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_AssignmentHistory(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	now := time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)
+	router := helpers.SetupTestAppWith(db, domain.FixedClock{Time: now}, domain.NewRandomSource(42))
+
+	createTeam := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "David", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	// Тест проверяет, что назначение и переназначение записываются в историю вместе с seed.
+	// Ожидается: две записи ASSIGNED и одна REASSIGNED, время создания и merge берутся из часов.
+	t.Run("GetHistory - records seed and actions", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var createResponse map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &createResponse)
+		reviewers := createResponse["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		require.Len(t, reviewers, 2)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     reviewers[0],
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getHistory?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		history := response["history"].([]interface{})
+		require.Len(t, history, 3)
+
+		first := history[0].(map[string]interface{})
+		assert.Equal(t, "ASSIGNED", first["action"])
+		assert.NotEmpty(t, first["seed"])
+		assert.Equal(t, now.Format(time.RFC3339), first["created_at"])
+
+		last := history[2].(map[string]interface{})
+		assert.Equal(t, "REASSIGNED", last["action"])
+		assert.Equal(t, reviewers[0], last["replaced_reviewer_id"])
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/merge", map[string]interface{}{
+			"pull_request_id": "pr-1",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var mergeResponse map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &mergeResponse)
+		assert.Equal(t, now.Format(time.RFC3339), mergeResponse["pr"].(map[string]interface{})["mergedAt"])
+	})
+
+	// Тест проверяет получение истории несуществующего PR.
+	// Ожидается: NOT_FOUND со статусом 404.
+	t.Run("GetHistory - PR not found", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getHistory?pull_request_id=missing", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
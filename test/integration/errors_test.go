@@ -0,0 +1,278 @@
+package integration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/delivery/http/handlers"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+// openAPIErrorCodes читает перечисление кодов ErrorResponse из спецификации.
+func openAPIErrorCodes(t *testing.T, path string) []string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var spec struct {
+		Components struct {
+			Schemas struct {
+				ErrorResponse struct {
+					Properties struct {
+						Error struct {
+							Properties struct {
+								Code struct {
+									Enum []string `yaml:"enum"`
+								} `yaml:"code"`
+							} `yaml:"properties"`
+						} `yaml:"error"`
+					} `yaml:"properties"`
+				} `yaml:"ErrorResponse"`
+			} `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &spec))
+
+	codes := spec.Components.Schemas.ErrorResponse.Properties.Error.Properties.Code.Enum
+	require.NotEmpty(t, codes)
+	return codes
+}
+
+// Тест проверяет, что каталог ошибок и перечисления кодов в спецификациях совпадают
+// Ожидается: одинаковый набор кодов в каталоге, openapi.yml и openapi-v2.yml
+func TestErrorCatalogue_MatchesOpenAPI(t *testing.T) {
+	var catalogue []string
+	for _, entry := range handlers.ErrorCatalogue() {
+		assert.NotZero(t, entry.Status, entry.Code)
+		catalogue = append(catalogue, entry.Code)
+	}
+
+	for _, path := range []string{"../../openapi.yml", "../../openapi-v2.yml"} {
+		assert.ElementsMatch(t, catalogue, openAPIErrorCodes(t, path), path)
+	}
+}
+
+func TestAPI_Errors(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	catalogue := make(map[string]int)
+	for _, entry := range handlers.ErrorCatalogue() {
+		catalogue[entry.Code] = entry.Status
+	}
+
+	type apiError struct {
+		Code    string                 `json:"code"`
+		Message string                 `json:"message"`
+		Details map[string]interface{} `json:"details"`
+	}
+
+	// expectError проверяет статус из каталога и код ошибки и возвращает её
+	expectError := func(t *testing.T, code string, method, path string, body interface{}, headers map[string]string) apiError {
+		w := helpers.PerformRequestWithHeaders(router, method, path, body, headers)
+		require.Equal(t, catalogue[code], w.Code, w.Body.String())
+
+		var response struct {
+			Error apiError `json:"error"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, code, response.Error.Code)
+		assert.NotEmpty(t, response.Error.Message)
+		return response.Error
+	}
+
+	// В backend активны u1, u2, u3 и u5, u4 неактивен; u5 не ревьюит u1 по
+	// правилу NEVER_PAIR, поэтому pr-1 получает ревьюеров u2 и u3
+	setup := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "Dave", "is_active": false},
+				{"user_id": "u5", "username": "Eve", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "frontend",
+			"members":   []map[string]interface{}{{"user_id": "f1", "username": "Frank", "is_active": true}},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/setPairingRules", map[string]interface{}{
+			"team_name": "backend",
+			"rules":     []map[string]interface{}{{"type": "NEVER_PAIR", "author_id": "u1", "reviewer_id": "u5"}},
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	addReviewer := func(userID string) map[string]interface{} {
+		return map[string]interface{}{"pull_request_id": "pr-1", "user_id": userID}
+	}
+
+	// Тест проверяет ошибки проверки запроса и аргументов
+	// Ожидается: INVALID_REQUEST с details.fields, INVALID_ARGUMENT с полем и причиной
+	t.Run("Request and argument errors", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		apiErr := expectError(t, "INVALID_REQUEST", http.MethodPost, "/team/add", map[string]interface{}{}, nil)
+		assert.NotEmpty(t, apiErr.Details["fields"])
+
+		apiErr = expectError(t, "INVALID_ARGUMENT", http.MethodPost, "/users/setSeniority", map[string]interface{}{
+			"user_id":   "u1",
+			"seniority": "principal",
+		}, nil)
+		assert.Equal(t, "seniority", apiErr.Details["field"])
+		assert.NotEmpty(t, apiErr.Details["reason"])
+	})
+
+	// Тест проверяет ошибки существования сущностей
+	// Ожидается: NOT_FOUND с типом и id сущности, TEAM_EXISTS и PR_EXISTS с именем и id
+	t.Run("Existence errors", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		apiErr := expectError(t, "NOT_FOUND", http.MethodGet, "/pullRequest/get?pull_request_id=pr-404", nil, nil)
+		assert.Equal(t, map[string]interface{}{"entity": "pull_request", "id": "pr-404"}, apiErr.Details)
+
+		apiErr = expectError(t, "NOT_FOUND", http.MethodGet, "/team/get?team_name=mobile", nil, nil)
+		assert.Equal(t, map[string]interface{}{"entity": "team", "id": "mobile"}, apiErr.Details)
+
+		apiErr = expectError(t, "NOT_FOUND", http.MethodPost, "/pullRequest/addReviewer", addReviewer("u404"), nil)
+		assert.Equal(t, map[string]interface{}{"entity": "user", "id": "u404"}, apiErr.Details)
+
+		apiErr = expectError(t, "TEAM_EXISTS", http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members":   []map[string]interface{}{{"user_id": "u9", "username": "Ivan", "is_active": true}},
+		}, nil)
+		assert.Equal(t, map[string]interface{}{"team_name": "backend"}, apiErr.Details)
+
+		apiErr = expectError(t, "PR_EXISTS", http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Again",
+			"author_id":         "u2",
+		}, nil)
+		assert.Equal(t, map[string]interface{}{"pull_request_id": "pr-1"}, apiErr.Details)
+	})
+
+	// Тест проверяет ошибки ручного назначения и снятия ревьюера
+	// Ожидается: код правила с PR и пользователем в details, без изменения ревьюеров
+	t.Run("Reviewer errors", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		for code, userID := range map[string]string{
+			"ALREADY_ASSIGNED":   "u2",
+			"REVIEWER_IS_AUTHOR": "u1",
+			"REVIEWER_INACTIVE":  "u4",
+		} {
+			apiErr := expectError(t, code, http.MethodPost, "/pullRequest/addReviewer", addReviewer(userID), nil)
+			assert.Equal(t, map[string]interface{}{"pull_request_id": "pr-1", "user_id": userID}, apiErr.Details, code)
+		}
+
+		apiErr := expectError(t, "TEAM_MISMATCH", http.MethodPost, "/pullRequest/addReviewer", addReviewer("f1"), nil)
+		assert.Equal(t, map[string]interface{}{"pull_request_id": "pr-1", "user_id": "f1", "team_name": "backend"}, apiErr.Details)
+
+		apiErr = expectError(t, "PAIRING_RULE_VIOLATION", http.MethodPost, "/pullRequest/addReviewer", addReviewer("u5"), nil)
+		assert.Equal(t, map[string]interface{}{"pull_request_id": "pr-1", "user_id": "u5", "author_id": "u1"}, apiErr.Details)
+
+		apiErr = expectError(t, "NOT_ASSIGNED", http.MethodPost, "/pullRequest/removeReviewer", addReviewer("u5"), nil)
+		assert.Equal(t, map[string]interface{}{"pull_request_id": "pr-1", "user_id": "u5"}, apiErr.Details)
+
+		apiErr = expectError(t, "NO_CANDIDATE", http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     "u2",
+		}, nil)
+		assert.Equal(t, map[string]interface{}{"pull_request_id": "pr-1", "user_id": "u2"}, apiErr.Details)
+	})
+
+	// Тест проверяет ошибки правила старшинства и версии ресурса
+	// Ожидается: SENIORITY_RULE_UNSATISFIED с правилом, PRECONDITION_FAILED с версиями
+	t.Run("Rule and version errors", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		apiErr := expectError(t, "PRECONDITION_FAILED", http.MethodPost, "/pullRequest/addReviewer",
+			addReviewer("u5"), map[string]string{"If-Match": `"999"`})
+		assert.Equal(t, float64(999), apiErr.Details["expected_version"])
+		assert.Contains(t, apiErr.Details, "current_version")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setSeniorityRule", map[string]interface{}{
+			"team_name":     "backend",
+			"min_reviewers": 1,
+			"min_level":     "lead",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		apiErr = expectError(t, "SENIORITY_RULE_UNSATISFIED", http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-2",
+			"pull_request_name": "Second PR",
+			"author_id":         "u1",
+		}, nil)
+		assert.Equal(t, map[string]interface{}{"min_reviewers": float64(1), "min_level": "lead"}, apiErr.Details)
+	})
+
+	// Тест проверяет ошибки Idempotency-Key
+	// Ожидается: другой запрос с ключом — IDEMPOTENCY_KEY_REUSED, незавершённый — IDEMPOTENCY_KEY_IN_PROGRESS
+	t.Run("Idempotency errors", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		reused := map[string]string{"Idempotency-Key": "key-reused"}
+		expectError(t, "NOT_FOUND", http.MethodPost, "/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-404"}, reused)
+		expectError(t, "IDEMPOTENCY_KEY_REUSED", http.MethodPost, "/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-405"}, reused)
+
+		// Запись без ответа — так выглядит ключ, пока исходный запрос выполняется
+		body := map[string]interface{}{"pull_request_id": "pr-1"}
+		payload, err := json.Marshal(body)
+		require.NoError(t, err)
+		hash := sha256.Sum256(append([]byte("POST /pullRequest/merge\n"), payload...))
+		now := time.Now().UTC()
+		_, err = db.Exec(
+			`INSERT INTO idempotency_keys (idempotency_key, request_hash, created_at, expires_at) VALUES ($1, $2, $3, $4)`,
+			"key-in-progress", hex.EncodeToString(hash[:]), now, now.Add(time.Hour),
+		)
+		require.NoError(t, err)
+
+		expectError(t, "IDEMPOTENCY_KEY_IN_PROGRESS", http.MethodPost, "/pullRequest/merge", body,
+			map[string]string{"Idempotency-Key": "key-in-progress"})
+	})
+
+	// Тест проверяет изменение смёрдженного PR
+	// Ожидается: PR_MERGED с id PR в details
+	t.Run("Merged PR errors", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-1"})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		apiErr := expectError(t, "PR_MERGED", http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     "u2",
+		}, nil)
+		assert.Equal(t, map[string]interface{}{"pull_request_id": "pr-1"}, apiErr.Details)
+	})
+}
@@ -0,0 +1,94 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_ExpertiseEndpoints(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createTeam := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "David", "is_active": true},
+				{"user_id": "u5", "username": "Eve", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	// Тест проверяет установку и получение тегов экспертизы.
+	// Ожидается: теги нормализованы (нижний регистр, без дублей), статус 200.
+	t.Run("SetTags and GetTags - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setTags", map[string]interface{}{
+			"user_id": "u2",
+			"tags":    []string{"Go", "postgres", "go"},
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/users/getTags?user_id=u2", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, []interface{}{"go", "postgres"}, response["tags"])
+	})
+
+	// Тест проверяет приоритет экспертов при назначении ревьюеров.
+	// Ожидается: единственный пользователь с тегом postgres назначен на PR с меткой postgres.
+	t.Run("CreatePR - prefers experts", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setTags", map[string]interface{}{
+			"user_id": "u4",
+			"tags":    []string{"postgres"},
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Tune indexes",
+			"author_id":         "u1",
+			"labels":            []string{"Postgres"},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		pr := response["pr"].(map[string]interface{})
+		assert.Contains(t, pr["assigned_reviewers"], "u4")
+		assert.Equal(t, []interface{}{"postgres"}, pr["labels"])
+	})
+
+	// Тест проверяет обработку тегов несуществующего пользователя.
+	// Ожидается: возвращается ошибка NOT_FOUND со статусом 404.
+	t.Run("SetTags - user not found", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setTags", map[string]interface{}{
+			"user_id": "nobody",
+			"tags":    []string{"go"},
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,76 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_FairRotation(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	// Тест проверяет, что ротация учитывает историю назначений команды.
+	// Ожидается: после 12 PR нагрузка четырёх ревьюеров ревьюеров различается не больше допустимого перекоса (2).
+	t.Run("CreatePR - balances load across team", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "David", "is_active": true},
+				{"user_id": "u5", "username": "Eve", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		for i := 1; i <= 12; i++ {
+			w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+				"pull_request_id":   fmt.Sprintf("pr-%d", i),
+				"pull_request_name": "Test PR",
+				"author_id":         "u1",
+			})
+			require.Equal(t, http.StatusCreated, w.Code)
+		}
+
+		var counts []int
+		for _, userID := range []string{"u2", "u3", "u4", "u5"} {
+			w = helpers.PerformRequest(router, http.MethodGet, "/users/getReview?user_id="+userID, nil)
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var response map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &response)
+			counts = append(counts, len(response["pull_requests"].([]interface{})))
+		}
+
+		assert.LessOrEqual(t, spreadOf(counts), 2, "counts %v", counts)
+	})
+}
+
+func spreadOf(counts []int) int {
+	if len(counts) == 0 {
+		return 0
+	}
+	min, max := counts[0], counts[0]
+	for _, count := range counts {
+		if count < min {
+			min = count
+		}
+		if count > max {
+			max = count
+		}
+	}
+	return max - min
+}
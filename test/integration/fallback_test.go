@@ -0,0 +1,132 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_FallbackTeams(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createTeams := func(t *testing.T) {
+		for _, team := range []map[string]interface{}{
+			{
+				"team_name": "backend-payments",
+				"members": []map[string]interface{}{
+					{"user_id": "u1", "username": "Alice", "is_active": true},
+					{"user_id": "u2", "username": "Bob", "is_active": false},
+				},
+			},
+			{
+				"team_name": "backend-platform",
+				"members": []map[string]interface{}{
+					{"user_id": "p1", "username": "Peter", "is_active": true},
+				},
+			},
+			{
+				"team_name": "infra",
+				"members": []map[string]interface{}{
+					{"user_id": "i1", "username": "Ivan", "is_active": true},
+					{"user_id": "i2", "username": "Irene", "is_active": true},
+				},
+			},
+		} {
+			w := helpers.PerformRequest(router, http.MethodPost, "/team/add", team)
+			require.Equal(t, http.StatusCreated, w.Code)
+		}
+	}
+
+	setFallbackTeams := func(teamName string, fallbackTeams ...string) int {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setFallbackTeams", map[string]interface{}{
+			"team_name":      teamName,
+			"fallback_teams": fallbackTeams,
+		})
+		return w.Code
+	}
+
+	// Тест проверяет сохранение запасных команд.
+	// Ожидается: статус 200, порядок запасных команд сохраняется в команде.
+	t.Run("SetFallbackTeams - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+
+		require.Equal(t, http.StatusOK, setFallbackTeams("backend-payments", "backend-platform", "infra"))
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/team/get?team_name=backend-payments", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		team := response["team"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"backend-platform", "infra"}, team["fallback_teams"])
+	})
+
+	// Тест проверяет валидацию запасных команд.
+	// Ожидается: 400 для самой команды и повторов, 404 для несуществующих команд.
+	t.Run("SetFallbackTeams - validation", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+
+		assert.Equal(t, http.StatusBadRequest, setFallbackTeams("backend-payments", "backend-payments"))
+		assert.Equal(t, http.StatusBadRequest, setFallbackTeams("backend-payments", "infra", "infra"))
+		assert.Equal(t, http.StatusNotFound, setFallbackTeams("backend-payments", "missing"))
+		assert.Equal(t, http.StatusNotFound, setFallbackTeams("missing", "infra"))
+	})
+
+	// Тест проверяет назначение из запасных команд, когда своя команда пуста.
+	// Ожидается: первый ревьюер из первой запасной команды, второй — из следующей, оба отмечены в fallback_reviewers.
+	t.Run("CreatePR - fills from fallback teams in order", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+		require.Equal(t, http.StatusOK, setFallbackTeams("backend-payments", "backend-platform", "infra"))
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		pr := response["pr"].(map[string]interface{})
+		reviewers := pr["assigned_reviewers"].([]interface{})
+		require.Len(t, reviewers, 2)
+		assert.Equal(t, "p1", reviewers[0])
+
+		fallbackReviewers := pr["fallback_reviewers"].([]interface{})
+		require.Len(t, fallbackReviewers, 2)
+		assert.Equal(t, "backend-platform", fallbackReviewers[0].(map[string]interface{})["team_name"])
+		assert.Equal(t, "infra", fallbackReviewers[1].(map[string]interface{})["team_name"])
+	})
+
+	// Тест проверяет создание PR без запасных команд в команде без свободных участников.
+	// Ожидается: PR создаётся без ревьюеров, fallback_reviewers отсутствует.
+	t.Run("CreatePR - no fallback configured", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		pr := response["pr"].(map[string]interface{})
+		assert.Empty(t, pr["assigned_reviewers"])
+		assert.NotContains(t, pr, "fallback_reviewers")
+	})
+}
@@ -0,0 +1,168 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_GraphQL(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	query := func(t *testing.T, query string, variables map[string]interface{}) map[string]interface{} {
+		w := helpers.PerformRequest(router, http.MethodPost, "/graphql", map[string]interface{}{
+			"query":     query,
+			"variables": variables,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+
+	errorCode := func(response map[string]interface{}) string {
+		errors, ok := response["errors"].([]interface{})
+		if !ok || len(errors) == 0 {
+			return ""
+		}
+		extensions := errors[0].(map[string]interface{})["extensions"].(map[string]interface{})
+		return extensions["code"].(string)
+	}
+
+	setup := func(t *testing.T) {
+		response := query(t, `mutation {
+			create_team(team_name: "backend", members: [
+				{user_id: "u1", username: "Alice", is_active: true},
+				{user_id: "u2", username: "Bob", is_active: true},
+				{user_id: "u3", username: "Charlie", is_active: true}
+			]) { team_name }
+		}`, nil)
+		require.Empty(t, response["errors"])
+
+		for _, prID := range []string{"pr-1", "pr-2"} {
+			response := query(t, `mutation($id: String!) {
+				create_pull_request(pull_request_id: $id, pull_request_name: "Feature", author_id: "u1") { pull_request_id }
+			}`, map[string]interface{}{"id": prID})
+			require.Empty(t, response["errors"])
+		}
+	}
+
+	// Тест проверяет запрос доски команды: участники, их ревью, авторы и ревьюеры PR
+	// Ожидается: у каждого ревьюера оба PR, автор и ревьюеры PR разрешены в пользователей
+	t.Run("Team board", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		response := query(t, `query($team: String!) {
+			team(team_name: $team) {
+				team_name
+				members {
+					user_id
+					reviews {
+						pull_request_id
+						status
+						author { username }
+						reviewers { user_id is_active }
+					}
+				}
+			}
+		}`, map[string]interface{}{"team": "backend"})
+		require.Empty(t, response["errors"])
+
+		team := response["data"].(map[string]interface{})["team"].(map[string]interface{})
+		assert.Equal(t, "backend", team["team_name"])
+
+		members := team["members"].([]interface{})
+		require.Len(t, members, 3)
+		for _, item := range members {
+			member := item.(map[string]interface{})
+			reviews := member["reviews"].([]interface{})
+			if member["user_id"] == "u1" {
+				assert.Empty(t, reviews)
+				continue
+			}
+
+			require.Len(t, reviews, 2)
+			for _, reviewItem := range reviews {
+				review := reviewItem.(map[string]interface{})
+				assert.Equal(t, "OPEN", review["status"])
+				assert.Equal(t, "Alice", review["author"].(map[string]interface{})["username"])
+				assert.Len(t, review["reviewers"], 2)
+			}
+		}
+	})
+
+	// Тест проверяет запрос несуществующих сущностей
+	// Ожидается: null в data и код NOT_FOUND в extensions
+	t.Run("Not found", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		response := query(t, `{ team(team_name: "unknown") { team_name } }`, nil)
+		assert.Nil(t, response["data"].(map[string]interface{})["team"])
+		assert.Equal(t, "NOT_FOUND", errorCode(response))
+
+		response = query(t, `{ pull_request(pull_request_id: "unknown") { pull_request_id } }`, nil)
+		assert.Equal(t, "NOT_FOUND", errorCode(response))
+	})
+
+	// Тест проверяет мутации merge и переназначения после merge
+	// Ожидается: PR в статусе MERGED, переназначение отклоняется с кодом PR_MERGED
+	t.Run("Merge and reassign mutations", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		response := query(t, `mutation { merge_pull_request(pull_request_id: "pr-1") { status merged_at } }`, nil)
+		require.Empty(t, response["errors"])
+		merged := response["data"].(map[string]interface{})["merge_pull_request"].(map[string]interface{})
+		assert.Equal(t, "MERGED", merged["status"])
+		assert.NotNil(t, merged["merged_at"])
+
+		response = query(t, `mutation { reassign_reviewer(pull_request_id: "pr-1", old_user_id: "u2") { replaced_by } }`, nil)
+		assert.Equal(t, "PR_MERGED", errorCode(response))
+	})
+
+	// Тест проверяет мутацию смены активности с политикой REASSIGN
+	// Ожидается: пользователь деактивирован, ревью переданы — замены нет, поэтому ревью помечены устаревшими
+	t.Run("Set is active mutation", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		response := query(t, `mutation {
+			set_is_active(user_id: "u2", is_active: false, on_deactivate: "REASSIGN") {
+				user { is_active }
+				affected_pull_requests { pull_request_id action error }
+			}
+		}`, nil)
+		require.Empty(t, response["errors"])
+
+		payload := response["data"].(map[string]interface{})["set_is_active"].(map[string]interface{})
+		assert.Equal(t, false, payload["user"].(map[string]interface{})["is_active"])
+
+		affected := payload["affected_pull_requests"].([]interface{})
+		require.Len(t, affected, 2)
+		for _, item := range affected {
+			assert.Equal(t, "MARKED_STALE", item.(map[string]interface{})["action"])
+			assert.Equal(t, "NO_CANDIDATE", item.(map[string]interface{})["error"])
+		}
+
+		response = query(t, `mutation { set_is_active(user_id: "u2", is_active: false, on_deactivate: "UNKNOWN") { user { user_id } } }`, nil)
+		assert.Equal(t, "INVALID_ARGUMENT", errorCode(response))
+	})
+
+	// Тест проверяет запрос без текста запроса
+	// Ожидается: 400 INVALID_REQUEST
+	t.Run("Missing query", func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/graphql", map[string]interface{}{})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
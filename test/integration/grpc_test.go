@@ -0,0 +1,147 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	reviewerv1 "github.com/avito-tech-backend-autumn-2025/api/proto/reviewer/v1"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestGRPC(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	conn, stop, err := helpers.SetupTestGRPC(db)
+	require.NoError(t, err)
+	defer stop()
+
+	ctx := context.Background()
+	teams := reviewerv1.NewTeamServiceClient(conn)
+	users := reviewerv1.NewUserServiceClient(conn)
+	prs := reviewerv1.NewPullRequestServiceClient(conn)
+
+	createTeam := func(t *testing.T) {
+		_, err := teams.CreateTeam(ctx, &reviewerv1.CreateTeamRequest{
+			TeamName: "backend",
+			Members: []*reviewerv1.TeamMember{
+				{UserId: "u1", Username: "Alice", IsActive: true},
+				{UserId: "u2", Username: "Bob", IsActive: true},
+				{UserId: "u3", Username: "Charlie", IsActive: true},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// Тест проверяет создание и получение команды через gRPC
+	// Ожидается: GetTeam возвращает созданную команду со всеми участниками
+	t.Run("Create and get team", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		team, err := teams.GetTeam(ctx, &reviewerv1.GetTeamRequest{TeamName: "backend"})
+		require.NoError(t, err)
+		assert.Equal(t, "backend", team.TeamName)
+		assert.Len(t, team.Members, 3)
+	})
+
+	// Тест проверяет повторное создание команды
+	// Ожидается: код AlreadyExists, сообщение доменной ошибки и доменный код TEAM_EXISTS в ErrorInfo
+	t.Run("Duplicate team", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		_, err := teams.CreateTeam(ctx, &reviewerv1.CreateTeamRequest{TeamName: "backend"})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Equal(t, "team_name already exists", status.Convert(err).Message())
+		assert.Equal(t, "TEAM_EXISTS", errorInfo(t, err).Reason)
+	})
+
+	// Тест проверяет запрос несуществующих сущностей
+	// Ожидается: код NotFound, подробности доменной ошибки в метаданных ErrorInfo
+	t.Run("Not found", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		_, err := teams.GetTeam(ctx, &reviewerv1.GetTeamRequest{TeamName: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		info := errorInfo(t, err)
+		assert.Equal(t, "NOT_FOUND", info.Reason)
+		assert.Equal(t, map[string]string{"entity": "team", "id": "unknown"}, info.Metadata)
+
+		_, err = prs.MergePullRequest(ctx, &reviewerv1.MergePullRequestRequest{PullRequestId: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	// Тест проверяет создание PR, merge и попытку переназначения после merge
+	// Ожидается: назначено два ревьюера без автора, переназначение отклоняется с FailedPrecondition
+	t.Run("Pull request lifecycle", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		pr, err := prs.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{
+			PullRequestId:   "pr-1",
+			PullRequestName: "Add feature",
+			AuthorId:        "u1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "OPEN", pr.Status)
+		assert.ElementsMatch(t, []string{"u2", "u3"}, pr.AssignedReviewers)
+		assert.NotNil(t, pr.CreatedAt)
+
+		merged, err := prs.MergePullRequest(ctx, &reviewerv1.MergePullRequestRequest{PullRequestId: "pr-1"})
+		require.NoError(t, err)
+		assert.Equal(t, "MERGED", merged.Status)
+		assert.NotNil(t, merged.MergedAt)
+
+		_, err = prs.ReassignReviewer(ctx, &reviewerv1.ReassignReviewerRequest{PullRequestId: "pr-1", OldUserId: "u2"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Equal(t, "PR_MERGED", errorInfo(t, err).Reason)
+	})
+
+	// Тест проверяет смену активности и список ревью пользователя
+	// Ожидается: пользователь деактивирован, его ревью видны в GetReviews
+	t.Run("Set is active and get reviews", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		_, err := prs.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{
+			PullRequestId:   "pr-1",
+			PullRequestName: "Add feature",
+			AuthorId:        "u1",
+		})
+		require.NoError(t, err)
+
+		reviews, err := users.GetReviews(ctx, &reviewerv1.GetReviewsRequest{UserId: "u2"})
+		require.NoError(t, err)
+		require.Len(t, reviews.PullRequests, 1)
+		assert.Equal(t, "pr-1", reviews.PullRequests[0].PullRequestId)
+
+		response, err := users.SetIsActive(ctx, &reviewerv1.SetIsActiveRequest{UserId: "u2", IsActive: false})
+		require.NoError(t, err)
+		assert.False(t, response.User.IsActive)
+		require.Len(t, response.AffectedPullRequests, 1)
+		assert.Equal(t, "MARKED_STALE", response.AffectedPullRequests[0].Action)
+
+		_, err = users.SetIsActive(ctx, &reviewerv1.SetIsActiveRequest{UserId: "u2", OnDeactivate: "UNKNOWN"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// errorInfo извлекает ErrorInfo из статуса gRPC.
+func errorInfo(t *testing.T, err error) *errdetails.ErrorInfo {
+	t.Helper()
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	require.Fail(t, "status has no ErrorInfo", "%v", err)
+	return nil
+}
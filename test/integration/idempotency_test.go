@@ -0,0 +1,240 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/postgres"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/idempotency"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_IdempotencyKeys(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	withKey := func(key string) map[string]string {
+		return map[string]string{"Idempotency-Key": key}
+	}
+
+	setup := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "Dave", "is_active": true},
+				{"user_id": "u5", "username": "Eve", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	createPR := map[string]interface{}{
+		"pull_request_id":   "pr-1",
+		"pull_request_name": "Feature",
+		"author_id":         "u1",
+	}
+
+	// Тест проверяет повтор создания PR с тем же ключом
+	// Ожидается: повтор получает исходный ответ 201 с заголовком Idempotent-Replayed вместо PR_EXISTS
+	t.Run("Create retry replays response", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		first := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", createPR, withKey("create-1"))
+		require.Equal(t, http.StatusCreated, first.Code)
+		assert.Empty(t, first.Header().Get("Idempotent-Replayed"))
+
+		retry := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", createPR, withKey("create-1"))
+		require.Equal(t, http.StatusCreated, retry.Code)
+		assert.Equal(t, "true", retry.Header().Get("Idempotent-Replayed"))
+		assert.JSONEq(t, first.Body.String(), retry.Body.String())
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", createPR)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	// Тест проверяет повтор переназначения с тем же ключом
+	// Ожидается: ревьюер заменяется один раз, повтор возвращает ту же замену
+	t.Run("Reassign retry does not pick another reviewer", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", createPR)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var created map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &created)
+		oldReviewer := created["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})[0].(string)
+
+		reassign := map[string]interface{}{"pull_request_id": "pr-1", "old_user_id": oldReviewer}
+		first := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/reassign", reassign, withKey("reassign-1"))
+		require.Equal(t, http.StatusOK, first.Code)
+
+		retry := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/reassign", reassign, withKey("reassign-1"))
+		require.Equal(t, http.StatusOK, retry.Code)
+		assert.JSONEq(t, first.Body.String(), retry.Body.String())
+
+		var response map[string]interface{}
+		json.Unmarshal(first.Body.Bytes(), &response)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getHistory?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var history map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &history)
+		assert.Len(t, history["history"], 3)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil)
+		var current map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &current)
+		assert.Contains(t, current["pr"].(map[string]interface{})["assigned_reviewers"], response["replaced_by"])
+	})
+
+	// Тест проверяет повторное использование ключа с другим запросом
+	// Ожидается: 422 IDEMPOTENCY_KEY_REUSED, запрос не выполняется
+	t.Run("Key reuse with different body", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", createPR, withKey("key-1"))
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-2",
+			"pull_request_name": "Other",
+			"author_id":         "u1",
+		}, withKey("key-1"))
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", response["error"].(map[string]interface{})["code"])
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-2", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	// Тест проверяет, что ошибочные ответы тоже повторяются
+	// Ожидается: повтор запроса, получившего 404, получает тот же 404
+	t.Run("Error responses are replayed", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", createPR, withKey("key-1"))
+		require.Equal(t, http.StatusNotFound, w.Code)
+
+		setup(t)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", createPR, withKey("key-1"))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+	})
+
+	// Тест проверяет истечение ключей
+	// Ожидается: истёкший ключ удаляется очисткой и может быть использован для нового запроса
+	t.Run("Expired keys are purged", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", createPR, withKey("key-1"))
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		repo := postgres.NewIdempotencyRepository(db)
+		deleted, err := idempotency.NewPurgeExpiredUseCase(repo, domain.SystemClock{}).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, 0, deleted)
+
+		later := domain.FixedClock{Time: time.Now().Add(25 * time.Hour)}
+		deleted, err = idempotency.NewPurgeExpiredUseCase(repo, later).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-2",
+			"pull_request_name": "Other",
+			"author_id":         "u1",
+		}, withKey("key-1"))
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	// Тест проверяет, что истёкший, но ещё не удалённый ключ занимается заново
+	// Ожидается: запрос с другим телом выполняется вместо ответа 422
+	t.Run("Expired key is acquired again", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		repo := postgres.NewIdempotencyRepository(db)
+		now := time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)
+
+		record, err := idempotency.NewBeginUseCase(repo, domain.FixedClock{Time: now}, time.Hour).Execute(idempotency.BeginRequest{
+			Key: "key-1", RequestHash: "first",
+		})
+		require.NoError(t, err)
+		require.NoError(t, idempotency.NewCompleteUseCase(repo).Execute(idempotency.CompleteRequest{
+			Record: record, StatusCode: http.StatusCreated, Body: []byte(`{}`),
+		}))
+
+		_, err = idempotency.NewBeginUseCase(repo, domain.FixedClock{Time: now.Add(30 * time.Minute)}, time.Hour).Execute(idempotency.BeginRequest{
+			Key: "key-1", RequestHash: "second",
+		})
+		assert.Equal(t, domain.ErrIdempotencyKeyReused, err)
+
+		record, err = idempotency.NewBeginUseCase(repo, domain.FixedClock{Time: now.Add(2 * time.Hour)}, time.Hour).Execute(idempotency.BeginRequest{
+			Key: "key-1", RequestHash: "second",
+		})
+		require.NoError(t, err)
+		assert.False(t, record.Completed)
+	})
+
+	// Тест проверяет запросы без ключа и не POST
+	// Ожидается: поведение не меняется, повтор без ключа выполняется заново
+	t.Run("Requests without key are not affected", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", createPR)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", createPR)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w = helpers.PerformRequestWithHeaders(router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil, withKey("key-1"))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+	})
+
+	// Тест проверяет, что запросы GraphQL и dry-run-проверки не попадают под идемпотентность
+	// Ожидается: ответ не повторяется из сохранённого, а ключ остаётся свободным для изменяющего запроса
+	t.Run("Read-only POST routes ignore key", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		graphQL := map[string]interface{}{"query": `{ team(team_name: "backend") { team_name } }`}
+		for _, request := range []struct {
+			path string
+			body interface{}
+		}{
+			{path: "/graphql", body: graphQL},
+			{path: "/pullRequest/preview", body: createPR},
+		} {
+			for i := 0; i < 2; i++ {
+				w := helpers.PerformRequestWithHeaders(router, http.MethodPost, request.path, request.body, withKey("key-1"))
+				assert.Equal(t, http.StatusOK, w.Code, request.path)
+				assert.Empty(t, w.Header().Get("Idempotent-Replayed"), request.path)
+			}
+		}
+
+		w := helpers.PerformRequestWithHeaders(router, http.MethodPost, "/pullRequest/create", createPR, withKey("key-1"))
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+}
@@ -0,0 +1,279 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_ManualReviewers(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createTeams := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true, "seniority": "junior"},
+				{"user_id": "u3", "username": "Charlie", "is_active": true, "seniority": "junior"},
+				{"user_id": "u4", "username": "David", "is_active": true, "seniority": "senior"},
+				{"user_id": "u5", "username": "Eve", "is_active": false},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "frontend",
+			"members": []map[string]interface{}{
+				{"user_id": "f1", "username": "Frank", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	// createPR создаёт PR автора u1 и возвращает назначенных ревьюеров и
+	// активного участника команды, оставшегося без назначения.
+	createPR := func(t *testing.T) ([]string, string) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+
+		var reviewers []string
+		assigned := make(map[string]bool)
+		for _, reviewer := range response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{}) {
+			reviewers = append(reviewers, reviewer.(string))
+			assigned[reviewer.(string)] = true
+		}
+		require.Len(t, reviewers, 2)
+
+		for _, userID := range []string{"u2", "u3", "u4"} {
+			if !assigned[userID] {
+				return reviewers, userID
+			}
+		}
+		t.Fatal("no free member left")
+		return nil, ""
+	}
+
+	errorCode := func(w *httptest.ResponseRecorder) string {
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response["error"].(map[string]interface{})["code"].(string)
+	}
+
+	assignedReviewers := func(w *httptest.ResponseRecorder) []interface{} {
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+	}
+
+	// Тест проверяет ручное добавление ревьюера.
+	// Ожидается: статус 200, ревьюер добавлен к назначенным, в истории запись ASSIGNED с seed 0.
+	t.Run("AddReviewer - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+		_, free := createPR(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/addReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         free,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+		reviewers := assignedReviewers(w)
+		assert.Len(t, reviewers, 3)
+		assert.Contains(t, reviewers, free)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getHistory?pull_request_id=pr-1", nil)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		history := response["history"].([]interface{})
+		last := history[len(history)-1].(map[string]interface{})
+		assert.Equal(t, "ASSIGNED", last["action"])
+		assert.Equal(t, free, last["reviewer_id"])
+		assert.Equal(t, "0", last["seed"])
+	})
+
+	// Тест проверяет валидацию ручного добавления.
+	// Ожидается: коды REVIEWER_IS_AUTHOR, ALREADY_ASSIGNED, REVIEWER_INACTIVE, TEAM_MISMATCH, PAIRING_RULE_VIOLATION со статусом 409 и 404 для неизвестного пользователя.
+	t.Run("AddReviewer - validation", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+		reviewers, free := createPR(t)
+
+		for userID, code := range map[string]string{
+			"u1":         "REVIEWER_IS_AUTHOR",
+			reviewers[0]: "ALREADY_ASSIGNED",
+			"u5":         "REVIEWER_INACTIVE",
+			"f1":         "TEAM_MISMATCH",
+		} {
+			w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/addReviewer", map[string]interface{}{
+				"pull_request_id": "pr-1",
+				"user_id":         userID,
+			})
+			require.Equal(t, http.StatusConflict, w.Code, userID)
+			assert.Equal(t, code, errorCode(w), userID)
+		}
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/addReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         "missing",
+		})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/setPairingRules", map[string]interface{}{
+			"team_name": "backend",
+			"rules":     []map[string]interface{}{{"type": "NEVER_PAIR", "author_id": "u1", "reviewer_id": free}},
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/addReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         free,
+		})
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, "PAIRING_RULE_VIOLATION", errorCode(w))
+	})
+
+	// Тест проверяет снятие ревьюера без замены.
+	// Ожидается: остаётся один ревьюер, повторное снятие возвращает NOT_ASSIGNED, в истории запись REMOVED.
+	t.Run("RemoveReviewer - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+		reviewers, _ := createPR(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/removeReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         reviewers[0],
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []interface{}{reviewers[1]}, assignedReviewers(w))
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/removeReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         reviewers[0],
+		})
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, "NOT_ASSIGNED", errorCode(w))
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getHistory?pull_request_id=pr-1", nil)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		history := response["history"].([]interface{})
+		last := history[len(history)-1].(map[string]interface{})
+		assert.Equal(t, "REMOVED", last["action"])
+		assert.Equal(t, reviewers[0], last["reviewer_id"])
+	})
+
+	// Тест проверяет, что нельзя снять единственного senior при правиле старшинства.
+	// Ожидается: SENIORITY_RULE_UNSATISFIED со статусом 409, снятие junior разрешено.
+	t.Run("RemoveReviewer - seniority rule", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setSeniorityRule", map[string]interface{}{
+			"team_name":     "backend",
+			"min_reviewers": 1,
+			"min_level":     "senior",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		reviewers, _ := createPR(t)
+		require.Contains(t, reviewers, "u4")
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/removeReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         "u4",
+		})
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, "SENIORITY_RULE_UNSATISFIED", errorCode(w))
+
+		for _, reviewer := range reviewers {
+			if reviewer != "u4" {
+				w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/removeReviewer", map[string]interface{}{
+					"pull_request_id": "pr-1",
+					"user_id":         reviewer,
+				})
+				assert.Equal(t, http.StatusOK, w.Code)
+			}
+		}
+	})
+
+	// Тест проверяет переназначение на выбранного пользователя.
+	// Ожидается: замена — указанный пользователь, для пользователя другой команды и уже назначенного — 409.
+	t.Run("ReassignReviewer - requested reviewer", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+		reviewers, free := createPR(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     reviewers[0],
+			"new_user_id":     "f1",
+		})
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, "TEAM_MISMATCH", errorCode(w))
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     reviewers[0],
+			"new_user_id":     reviewers[1],
+		})
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, "ALREADY_ASSIGNED", errorCode(w))
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     reviewers[0],
+			"new_user_id":     free,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, free, response["replaced_by"])
+		assert.ElementsMatch(t, []interface{}{free, reviewers[1]}, response["pr"].(map[string]interface{})["assigned_reviewers"])
+	})
+
+	// Тест проверяет ручные изменения после merge.
+	// Ожидается: PR_MERGED со статусом 409 для добавления и снятия.
+	t.Run("Manual changes - merged PR", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+		reviewers, free := createPR(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/merge", map[string]interface{}{
+			"pull_request_id": "pr-1",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/addReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         free,
+		})
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, "PR_MERGED", errorCode(w))
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/removeReviewer", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"user_id":         reviewers[0],
+		})
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, "PR_MERGED", errorCode(w))
+	})
+}
@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_OwnershipEndpoints(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createTeams := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "platform",
+			"members": []map[string]interface{}{
+				{"user_id": "p1", "username": "Paul", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	// Тест проверяет, что владелец из правила назначается ревьюером по изменённым файлам.
+	// Ожидается: p1 из команды platform назначен на PR, меняющий миграции, статус 201.
+	t.Run("CreatePR - assigns owner for matched rule", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/ownership/setRules", map[string]interface{}{
+			"rules": []map[string]interface{}{
+				{"pattern": "*.go", "team_name": "backend"},
+				{"pattern": "/migrations/", "team_name": "platform"},
+			},
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Add index",
+			"author_id":         "u1",
+			"changed_files":     []string{"migrations/003_add_index.up.sql", "internal/app.go"},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		reviewers := response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		assert.Contains(t, reviewers, "p1")
+		assert.NotContains(t, reviewers, "u1")
+	})
+
+	// Тест проверяет dry-run правил владения.
+	// Ожидается: для каждого файла возвращается последнее подходящее правило или null.
+	t.Run("Explain - shows matched rule per file", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeams(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/ownership/setRules", map[string]interface{}{
+			"rules": []map[string]interface{}{
+				{"pattern": "*", "team_name": "backend"},
+				{"pattern": "docs/**/*.md", "user_ids": []string{"p1"}},
+			},
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/ownership/explain", map[string]interface{}{
+			"changed_files": []string{"docs/api/readme.md", "cmd/server/main.go"},
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		files := response["files"].([]interface{})
+		require.Len(t, files, 2)
+
+		docsRule := files[0].(map[string]interface{})["rule"].(map[string]interface{})
+		assert.Equal(t, "docs/**/*.md", docsRule["pattern"])
+		mainRule := files[1].(map[string]interface{})["rule"].(map[string]interface{})
+		assert.Equal(t, "backend", mainRule["team_name"])
+	})
+
+	// Тест проверяет валидацию правил владения.
+	// Ожидается: правило с неизвестной командой отклоняется с NOT_FOUND, статус 404.
+	t.Run("SetRules - unknown team", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/ownership/setRules", map[string]interface{}{
+			"rules": []map[string]interface{}{
+				{"pattern": "*.go", "team_name": "nonexistent"},
+			},
+		})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,210 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_PairingRules(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createTeam := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "David", "is_active": true},
+				{"user_id": "u5", "username": "Eve", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	setRules := func(rules ...map[string]interface{}) int {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setPairingRules", map[string]interface{}{
+			"team_name": "backend",
+			"rules":     rules,
+		})
+		return w.Code
+	}
+
+	// Тест проверяет сохранение правил пар команды.
+	// Ожидается: статус 200, правила возвращаются в команде.
+	t.Run("SetPairingRules - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		require.Equal(t, http.StatusOK, setRules(
+			map[string]interface{}{"type": "NEVER_PAIR", "author_id": "u1", "reviewer_id": "u2"},
+			map[string]interface{}{"type": "ALWAYS_INCLUDE", "reviewer_id": "u5"},
+		))
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/team/get?team_name=backend", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		rules := response["team"].(map[string]interface{})["pairing_rules"].([]interface{})
+		require.Len(t, rules, 2)
+		assert.Equal(t, "NEVER_PAIR", rules[0].(map[string]interface{})["type"])
+		assert.Equal(t, "u5", rules[1].(map[string]interface{})["reviewer_id"])
+	})
+
+	// Тест проверяет валидацию правил пар.
+	// Ожидается: 400 для неизвестного типа и противоречия NEVER_PAIR, 404 для пользователя не из команды.
+	t.Run("SetPairingRules - validation", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		assert.Equal(t, http.StatusBadRequest, setRules(
+			map[string]interface{}{"type": "SOMETIMES", "author_id": "u1", "reviewer_id": "u2"},
+		))
+		assert.Equal(t, http.StatusBadRequest, setRules(
+			map[string]interface{}{"type": "PREFER_PAIR", "reviewer_id": "u2"},
+		))
+		assert.Equal(t, http.StatusBadRequest, setRules(
+			map[string]interface{}{"type": "NEVER_PAIR", "author_id": "u1", "reviewer_id": "u2"},
+			map[string]interface{}{"type": "PREFER_PAIR", "author_id": "u2", "reviewer_id": "u1"},
+		))
+		assert.Equal(t, http.StatusNotFound, setRules(
+			map[string]interface{}{"type": "NEVER_PAIR", "author_id": "u1", "reviewer_id": "ghost"},
+		))
+	})
+
+	// Тест проверяет, что создание PR соблюдает правила пар.
+	// Ожидается: ALWAYS_INCLUDE назначен всегда, пара NEVER_PAIR не назначается ни в одну сторону.
+	t.Run("CreatePR - honors pairing rules", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		require.Equal(t, http.StatusOK, setRules(
+			map[string]interface{}{"type": "NEVER_PAIR", "author_id": "u1", "reviewer_id": "u2"},
+			map[string]interface{}{"type": "ALWAYS_INCLUDE", "author_id": "u1", "reviewer_id": "u3"},
+		))
+
+		for i := 1; i <= 5; i++ {
+			w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+				"pull_request_id":   fmt.Sprintf("pr-u1-%d", i),
+				"pull_request_name": "Test PR",
+				"author_id":         "u1",
+			})
+			require.Equal(t, http.StatusCreated, w.Code)
+
+			var response map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &response)
+			reviewers := response["pr"].(map[string]interface{})["assigned_reviewers"]
+			assert.Contains(t, reviewers, "u3")
+			assert.NotContains(t, reviewers, "u2")
+
+			w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+				"pull_request_id":   fmt.Sprintf("pr-u2-%d", i),
+				"pull_request_name": "Test PR",
+				"author_id":         "u2",
+			})
+			require.Equal(t, http.StatusCreated, w.Code)
+
+			json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NotContains(t, response["pr"].(map[string]interface{})["assigned_reviewers"], "u1")
+		}
+	})
+
+	// Тест проверяет, что переназначение соблюдает правила пар автора.
+	// Ожидается: пара NEVER_PAIR не выбирается, освободившийся ALWAYS_INCLUDE выбирается первым.
+	t.Run("Reassign - honors never pair", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		require.Equal(t, http.StatusOK, setRules(
+			map[string]interface{}{"type": "ALWAYS_INCLUDE", "author_id": "u1", "reviewer_id": "u2"},
+			map[string]interface{}{"type": "ALWAYS_INCLUDE", "author_id": "u1", "reviewer_id": "u3"},
+			map[string]interface{}{"type": "NEVER_PAIR", "author_id": "u1", "reviewer_id": "u4"},
+		))
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     "u2",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "u5", response["replaced_by"])
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     "u3",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "u2", response["replaced_by"])
+	})
+
+	// Тест проверяет объяснение правил пар для автора.
+	// Ожидается: автор и пара NEVER_PAIR исключены, ALWAYS_INCLUDE обязателен, PREFER_PAIR предпочтителен.
+	t.Run("ExplainPairing - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		require.Equal(t, http.StatusOK, setRules(
+			map[string]interface{}{"type": "NEVER_PAIR", "author_id": "u2", "reviewer_id": "u1"},
+			map[string]interface{}{"type": "ALWAYS_INCLUDE", "reviewer_id": "u3"},
+			map[string]interface{}{"type": "PREFER_PAIR", "author_id": "u1", "reviewer_id": "u4"},
+		))
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/explainPairing", map[string]interface{}{
+			"author_id": "u1",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "backend", response["team_name"])
+
+		statuses := make(map[string]string)
+		reasons := make(map[string]string)
+		for _, item := range response["candidates"].([]interface{}) {
+			candidate := item.(map[string]interface{})
+			statuses[candidate["user_id"].(string)] = candidate["status"].(string)
+			reason, _ := candidate["reason"].(string)
+			reasons[candidate["user_id"].(string)] = reason
+		}
+
+		assert.Equal(t, map[string]string{
+			"u1": "EXCLUDED", "u2": "EXCLUDED", "u3": "REQUIRED", "u4": "PREFERRED", "u5": "ELIGIBLE",
+		}, statuses)
+		assert.Equal(t, "AUTHOR", reasons["u1"])
+		assert.Equal(t, "NEVER_PAIR", reasons["u2"])
+	})
+
+	// Тест проверяет объяснение для несуществующего автора.
+	// Ожидается: NOT_FOUND со статусом 404.
+	t.Run("ExplainPairing - author not found", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/explainPairing", map[string]interface{}{
+			"author_id": "ghost",
+		})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
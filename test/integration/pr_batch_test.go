@@ -0,0 +1,294 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_PRBatch(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	setup := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "Dave", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	batch := func(t *testing.T, path string, body map[string]interface{}) map[string]interface{} {
+		w := helpers.PerformRequest(router, http.MethodPost, path, body)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+
+	results := func(response map[string]interface{}) []map[string]interface{} {
+		var items []map[string]interface{}
+		for _, item := range response["results"].([]interface{}) {
+			items = append(items, item.(map[string]interface{}))
+		}
+		return items
+	}
+
+	prStatus := func(prID string) int {
+		return helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get?pull_request_id="+prID, nil).Code
+	}
+
+	// Тест проверяет пакетное создание в режиме BEST_EFFORT
+	// Ожидается: корректные PR созданы, дубликат и неизвестный автор — FAILED с доменным кодом
+	t.Run("Batch create best effort", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		response := batch(t, "/pullRequest/batchCreate", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-1", "pull_request_name": "One", "author_id": "u1"},
+				{"pull_request_id": "pr-1", "pull_request_name": "Duplicate", "author_id": "u1"},
+				{"pull_request_id": "pr-2", "pull_request_name": "Unknown", "author_id": "u404"},
+				{"pull_request_id": "pr-3", "pull_request_name": "Three", "author_id": "u2"},
+			},
+		})
+
+		assert.Equal(t, "BEST_EFFORT", response["mode"])
+		assert.Equal(t, float64(2), response["succeeded"])
+		assert.Equal(t, float64(2), response["failed"])
+
+		items := results(response)
+		require.Len(t, items, 4)
+		assert.Equal(t, "SUCCEEDED", items[0]["status"])
+		assert.Equal(t, "pr-1", items[0]["pr"].(map[string]interface{})["pull_request_id"])
+		assert.Equal(t, "FAILED", items[1]["status"])
+		assert.Equal(t, "PR_EXISTS", items[1]["error"])
+		assert.Nil(t, items[1]["pr"])
+		assert.Equal(t, "FAILED", items[2]["status"])
+		assert.Equal(t, "NOT_FOUND", items[2]["error"])
+		assert.Equal(t, "SUCCEEDED", items[3]["status"])
+		assert.Equal(t, float64(3), items[3]["index"])
+
+		assert.Equal(t, http.StatusOK, prStatus("pr-1"))
+		assert.Equal(t, http.StatusNotFound, prStatus("pr-2"))
+		assert.Equal(t, http.StatusOK, prStatus("pr-3"))
+	})
+
+	// Тест проверяет пакет больше одной части
+	// Ожидается: все элементы из нескольких транзакций сохранены
+	t.Run("Batch create over several chunks", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		var items []map[string]interface{}
+		for i := 0; i < 120; i++ {
+			items = append(items, map[string]interface{}{
+				"pull_request_id":   fmt.Sprintf("pr-%d", i),
+				"pull_request_name": "Bulk",
+				"author_id":         "u1",
+			})
+		}
+
+		response := batch(t, "/pullRequest/batchCreate", map[string]interface{}{"items": items})
+		assert.Equal(t, float64(120), response["succeeded"])
+		assert.Equal(t, false, response["aborted"])
+		assert.Equal(t, http.StatusOK, prStatus("pr-0"))
+		assert.Equal(t, http.StatusOK, prStatus("pr-119"))
+	})
+
+	// Тест проверяет ошибку БД посреди пакета BEST_EFFORT
+	// Ожидается: 200 с частичным отчётом и aborted — зафиксированная часть SUCCEEDED, выполненные элементы
+	// текущей части ROLLED_BACK, элемент с ошибкой FAILED, оставшиеся SKIPPED
+	t.Run("Batch create best effort stops on infrastructure error", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		_, err := db.Exec(`
+			CREATE FUNCTION fail_pr_55() RETURNS trigger AS $$
+			BEGIN
+				IF NEW.pull_request_id = 'pr-55' THEN
+					RAISE EXCEPTION 'storage failure';
+				END IF;
+				RETURN NEW;
+			END;
+			$$ LANGUAGE plpgsql;
+			CREATE TRIGGER fail_pr_55 BEFORE INSERT ON pull_requests
+				FOR EACH ROW EXECUTE FUNCTION fail_pr_55();`)
+		require.NoError(t, err)
+		defer db.Exec(`DROP TRIGGER fail_pr_55 ON pull_requests; DROP FUNCTION fail_pr_55();`)
+
+		var items []map[string]interface{}
+		for i := 0; i < 120; i++ {
+			items = append(items, map[string]interface{}{
+				"pull_request_id":   fmt.Sprintf("pr-%d", i),
+				"pull_request_name": "Bulk",
+				"author_id":         "u1",
+			})
+		}
+
+		response := batch(t, "/pullRequest/batchCreate", map[string]interface{}{"items": items})
+		assert.Equal(t, float64(50), response["succeeded"])
+		assert.Equal(t, float64(1), response["failed"])
+		assert.Equal(t, true, response["aborted"])
+		assert.Equal(t, "INTERNAL_ERROR", response["error"])
+
+		report := results(response)
+		require.Len(t, report, 120)
+		assert.Equal(t, "SUCCEEDED", report[49]["status"])
+		assert.Equal(t, "ROLLED_BACK", report[50]["status"])
+		assert.Nil(t, report[50]["pr"])
+		assert.Nil(t, report[50]["error"])
+		assert.Equal(t, "FAILED", report[55]["status"])
+		assert.Equal(t, "INTERNAL_ERROR", report[55]["error"])
+		assert.Equal(t, "SKIPPED", report[56]["status"])
+		assert.Nil(t, report[56]["error"])
+		assert.Equal(t, "SKIPPED", report[119]["status"])
+
+		assert.Equal(t, http.StatusOK, prStatus("pr-49"))
+		assert.Equal(t, http.StatusNotFound, prStatus("pr-50"))
+		assert.Equal(t, http.StatusNotFound, prStatus("pr-100"))
+	})
+
+	// Тест проверяет пакетное создание в режиме ALL_OR_NOTHING
+	// Ожидается: ошибка элемента откатывает пакет — ROLLED_BACK и SKIPPED, ни один PR не сохранён
+	t.Run("Batch create all or nothing", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		response := batch(t, "/pullRequest/batchCreate", map[string]interface{}{
+			"mode": "ALL_OR_NOTHING",
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-1", "pull_request_name": "One", "author_id": "u1"},
+				{"pull_request_id": "pr-2", "pull_request_name": "Unknown", "author_id": "u404"},
+				{"pull_request_id": "pr-3", "pull_request_name": "Three", "author_id": "u2"},
+			},
+		})
+
+		assert.Equal(t, "ALL_OR_NOTHING", response["mode"])
+		assert.Equal(t, float64(0), response["succeeded"])
+		assert.Equal(t, float64(1), response["failed"])
+
+		items := results(response)
+		require.Len(t, items, 3)
+		assert.Equal(t, "ROLLED_BACK", items[0]["status"])
+		assert.Nil(t, items[0]["pr"])
+		assert.Equal(t, "FAILED", items[1]["status"])
+		assert.Equal(t, "NOT_FOUND", items[1]["error"])
+		assert.Equal(t, "SKIPPED", items[2]["status"])
+
+		assert.Equal(t, http.StatusNotFound, prStatus("pr-1"))
+		assert.Equal(t, http.StatusNotFound, prStatus("pr-3"))
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "One",
+			"author_id":         "u1",
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	// Тест проверяет пакетный merge
+	// Ожидается: PR смёрджены, повторный merge успешен, неизвестный PR — NOT_FOUND
+	t.Run("Batch merge", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		batch(t, "/pullRequest/batchCreate", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-1", "pull_request_name": "One", "author_id": "u1"},
+				{"pull_request_id": "pr-2", "pull_request_name": "Two", "author_id": "u2"},
+			},
+		})
+
+		response := batch(t, "/pullRequest/batchMerge", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-1"},
+				{"pull_request_id": "pr-1"},
+				{"pull_request_id": "pr-404"},
+				{"pull_request_id": "pr-2"},
+			},
+		})
+
+		items := results(response)
+		require.Len(t, items, 4)
+		assert.Equal(t, "SUCCEEDED", items[0]["status"])
+		assert.Equal(t, "MERGED", items[0]["pr"].(map[string]interface{})["status"])
+		assert.Equal(t, "SUCCEEDED", items[1]["status"])
+		assert.Equal(t, "NOT_FOUND", items[2]["error"])
+		assert.Equal(t, "SUCCEEDED", items[3]["status"])
+	})
+
+	// Тест проверяет пакетное переназначение
+	// Ожидается: замена в replaced_by, ревьюер не из PR — NOT_ASSIGNED, смёрдженный PR — PR_MERGED
+	t.Run("Batch reassign", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		created := results(batch(t, "/pullRequest/batchCreate", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-1", "pull_request_name": "One", "author_id": "u1"},
+				{"pull_request_id": "pr-2", "pull_request_name": "Two", "author_id": "u1"},
+			},
+		}))
+		reviewers := created[0]["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		require.NotEmpty(t, reviewers)
+		oldReviewer := reviewers[0].(string)
+
+		batch(t, "/pullRequest/batchMerge", map[string]interface{}{
+			"items": []map[string]interface{}{{"pull_request_id": "pr-2"}},
+		})
+
+		response := batch(t, "/pullRequest/batchReassign", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-1", "old_user_id": oldReviewer},
+				{"pull_request_id": "pr-1", "old_user_id": "u1"},
+				{"pull_request_id": "pr-2", "old_user_id": oldReviewer},
+			},
+		})
+
+		items := results(response)
+		require.Len(t, items, 3)
+		assert.Equal(t, "SUCCEEDED", items[0]["status"])
+		assert.NotEmpty(t, items[0]["replaced_by"])
+		assert.NotEqual(t, oldReviewer, items[0]["replaced_by"])
+		assert.NotContains(t, items[0]["pr"].(map[string]interface{})["assigned_reviewers"], oldReviewer)
+		assert.Equal(t, "NOT_ASSIGNED", items[1]["error"])
+		assert.Equal(t, "PR_MERGED", items[2]["error"])
+	})
+
+	// Тест проверяет отказ на некорректный пакет
+	// Ожидается: 400 на неизвестный режим, пустой и слишком большой пакет
+	t.Run("Invalid batch", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		tooMany := make([]map[string]interface{}, 501)
+		for i := range tooMany {
+			tooMany[i] = map[string]interface{}{"pull_request_id": fmt.Sprintf("pr-%d", i)}
+		}
+
+		for _, body := range []map[string]interface{}{
+			{"mode": "SOMETIMES", "items": []map[string]interface{}{{"pull_request_id": "pr-1"}}},
+			{"items": []map[string]interface{}{}},
+			{"items": tooMany},
+		} {
+			w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/batchMerge", body)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		}
+	})
+}
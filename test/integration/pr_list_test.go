@@ -0,0 +1,201 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_PRListing(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createPR := func(t *testing.T, prID, authorID string) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   prID,
+			"pull_request_name": "Feature " + prID,
+			"author_id":         authorID,
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	// setup создаёт две команды и PR с разнесёнными датами создания:
+	// pr-1 и pr-2 в backend, pr-3 в payments, pr-2 смержен.
+	setup := func(t *testing.T) {
+		for teamName, members := range map[string][]map[string]interface{}{
+			"backend": {
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+			},
+			"payments": {
+				{"user_id": "p1", "username": "Eve", "is_active": true},
+				{"user_id": "p2", "username": "Frank", "is_active": true},
+			},
+		} {
+			w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+				"team_name": teamName,
+				"members":   members,
+			})
+			require.Equal(t, http.StatusCreated, w.Code)
+		}
+
+		createPR(t, "pr-1", "u1")
+		createPR(t, "pr-2", "u2")
+		createPR(t, "pr-3", "p1")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/merge", map[string]interface{}{
+			"pull_request_id": "pr-2",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		for prID, createdAt := range map[string]string{
+			"pr-1": "2025-01-10 12:00:00+00",
+			"pr-2": "2025-01-15 12:00:00+00",
+			"pr-3": "2025-01-20 12:00:00+00",
+		} {
+			_, err := db.Exec(`UPDATE pull_requests SET created_at = $2 WHERE pull_request_id = $1`, prID, createdAt)
+			require.NoError(t, err)
+		}
+	}
+
+	list := func(t *testing.T, query string) ([]string, map[string]interface{}) {
+		w := helpers.PerformRequest(router, http.MethodGet, "/pullRequest/list"+query, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+
+		prIDs := []string{}
+		for _, item := range response["pull_requests"].([]interface{}) {
+			prIDs = append(prIDs, item.(map[string]interface{})["pull_request_id"].(string))
+		}
+		return prIDs, response
+	}
+
+	// Тест проверяет получение PR по идентификатору
+	// Ожидается: PR с ревьюерами в обёртке pr, неизвестный PR — 404, без параметра — 400
+	t.Run("Get PR", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		pr := response["pr"].(map[string]interface{})
+		assert.Equal(t, "pr-1", pr["pull_request_id"])
+		assert.Equal(t, "u1", pr["author_id"])
+		assert.ElementsMatch(t, []interface{}{"u2", "u3"}, pr["assigned_reviewers"])
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get?pull_request_id=unknown", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/get", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	// Тест проверяет список без фильтров
+	// Ожидается: все PR от новых к старым, limit по умолчанию 20
+	t.Run("List without filters", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		prIDs, response := list(t, "")
+		assert.Equal(t, []string{"pr-3", "pr-2", "pr-1"}, prIDs)
+		assert.Equal(t, float64(3), response["total"])
+		assert.Equal(t, float64(20), response["limit"])
+		assert.Equal(t, float64(0), response["offset"])
+	})
+
+	// Тест проверяет фильтры по автору, команде, ревьюеру и статусу
+	// Ожидается: в выборку попадают только PR, подходящие под все переданные фильтры
+	t.Run("List with filters", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		prIDs, _ := list(t, "?author_id=u1")
+		assert.Equal(t, []string{"pr-1"}, prIDs)
+
+		prIDs, _ = list(t, "?team_name=backend")
+		assert.Equal(t, []string{"pr-2", "pr-1"}, prIDs)
+
+		prIDs, _ = list(t, "?reviewer_id=p2")
+		assert.Equal(t, []string{"pr-3"}, prIDs)
+
+		prIDs, _ = list(t, "?status=MERGED")
+		assert.Equal(t, []string{"pr-2"}, prIDs)
+
+		prIDs, response := list(t, "?team_name=backend&status=OPEN")
+		assert.Equal(t, []string{"pr-1"}, prIDs)
+		assert.Equal(t, float64(1), response["total"])
+	})
+
+	// Тест проверяет фильтр по датам создания
+	// Ожидается: обе границы включительно
+	t.Run("List by date range", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		prIDs, _ := list(t, "?created_from=2025-01-10&created_to=2025-01-15")
+		assert.Equal(t, []string{"pr-2", "pr-1"}, prIDs)
+
+		prIDs, _ = list(t, "?created_from=2025-01-16")
+		assert.Equal(t, []string{"pr-3"}, prIDs)
+	})
+
+	// Тест проверяет интервал из одного дня
+	// Ожидается: при равных границах возвращаются PR, созданные в этот день, а не 400
+	t.Run("List by equal date bounds", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		prIDs, _ := list(t, "?created_from=2025-01-15&created_to=2025-01-15")
+		assert.Equal(t, []string{"pr-2"}, prIDs)
+	})
+
+	// Тест проверяет постраничную выдачу
+	// Ожидается: страница содержит limit PR начиная с offset, total — число PR без учёта страницы
+	t.Run("List pagination", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		prIDs, response := list(t, "?limit=2")
+		assert.Equal(t, []string{"pr-3", "pr-2"}, prIDs)
+		assert.Equal(t, float64(3), response["total"])
+
+		prIDs, response = list(t, "?limit=2&offset=2")
+		assert.Equal(t, []string{"pr-1"}, prIDs)
+		assert.Equal(t, float64(3), response["total"])
+
+		prIDs, _ = list(t, "?offset=10")
+		assert.Empty(t, prIDs)
+	})
+
+	// Тест проверяет некорректные параметры списка
+	// Ожидается: 400 на неизвестный статус, неверную дату, нечисловой и слишком большой limit, обратный интервал
+	t.Run("List invalid parameters", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		for _, query := range []string{
+			"?status=CLOSED",
+			"?created_from=10.01.2025",
+			"?limit=abc",
+			"?limit=101",
+			"?offset=-1",
+			"?created_from=2025-01-20&created_to=2025-01-10",
+		} {
+			w := helpers.PerformRequest(router, http.MethodGet, "/pullRequest/list"+query, nil)
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_AssignmentReasoning(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	now := time.Date(2025, time.November, 12, 12, 0, 0, 0, time.UTC)
+	router := helpers.SetupTestAppWith(db, domain.FixedClock{Time: now}, domain.NewRandomSource(42))
+
+	createTeam := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "David", "is_active": true},
+				{"user_id": "u5", "username": "Eve", "is_active": false},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	decisionsByUser := func(candidates []interface{}) map[string]map[string]interface{} {
+		result := make(map[string]map[string]interface{})
+		for _, candidate := range candidates {
+			decision := candidate.(map[string]interface{})
+			result[decision["user_id"].(string)] = decision
+		}
+		return result
+	}
+
+	// Тест проверяет, что предпросмотр объясняет выбор и ничего не сохраняет.
+	// Ожидается: два выбранных ревьюера, автор и неактивный исключены с причинами, PR не создан.
+	t.Run("PreviewPR - explains without persisting", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		require.Equal(t, http.StatusOK, helpers.PerformRequest(router, http.MethodPost, "/team/setPairingRules", map[string]interface{}{
+			"team_name": "backend",
+			"rules":     []map[string]interface{}{{"type": "NEVER_PAIR", "author_id": "u1", "reviewer_id": "u2"}},
+		}).Code)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/preview", map[string]interface{}{
+			"author_id": "u1",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		reviewers := response["assigned_reviewers"].([]interface{})
+		assert.ElementsMatch(t, []interface{}{"u3", "u4"}, reviewers)
+		assert.NotEmpty(t, response["seed"])
+
+		decisions := decisionsByUser(response["candidates"].([]interface{}))
+		require.Len(t, decisions, 5)
+		assert.Equal(t, "AUTHOR", decisions["u1"]["reason"])
+		assert.Equal(t, "NEVER_PAIR", decisions["u2"]["reason"])
+		assert.Equal(t, "EXCLUDED", decisions["u5"]["status"])
+		assert.Equal(t, "INACTIVE", decisions["u5"]["reason"])
+		assert.Equal(t, "CHOSEN", decisions["u3"]["status"])
+		assert.Equal(t, "TOP_RANKED", decisions["u3"]["reason"])
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/users/getReview?user_id=u3", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		var reviews map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &reviews)
+		assert.Empty(t, reviews["pull_requests"])
+	})
+
+	// Тест проверяет предпросмотр для несуществующего автора.
+	// Ожидается: NOT_FOUND со статусом 404.
+	t.Run("PreviewPR - author not found", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/preview", map[string]interface{}{
+			"author_id": "missing",
+		})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	// Тест проверяет, что обоснование сохраняется при создании PR и переназначении.
+	// Ожидается: записи ASSIGNED и REASSIGNED, при переназначении старый ревьюер исключён как REPLACED.
+	t.Run("GetReasoning - stored with assignments", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var createResponse map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &createResponse)
+		reviewers := createResponse["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		require.Len(t, reviewers, 2)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     reviewers[0],
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var reassignResponse map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &reassignResponse)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getReasoning?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		reasoning := response["reasoning"].([]interface{})
+		require.Len(t, reasoning, 2)
+
+		assigned := reasoning[0].(map[string]interface{})
+		assert.Equal(t, "ASSIGNED", assigned["action"])
+		assert.Equal(t, now.Format(time.RFC3339), assigned["created_at"])
+		decisions := decisionsByUser(assigned["candidates"].([]interface{}))
+		for _, reviewer := range reviewers {
+			assert.Equal(t, "CHOSEN", decisions[reviewer.(string)]["status"])
+		}
+
+		reassigned := reasoning[1].(map[string]interface{})
+		assert.Equal(t, "REASSIGNED", reassigned["action"])
+		decisions = decisionsByUser(reassigned["candidates"].([]interface{}))
+		assert.Equal(t, "REPLACED", decisions[reviewers[0].(string)]["reason"])
+		assert.Equal(t, "ALREADY_ASSIGNED", decisions[reviewers[1].(string)]["reason"])
+		assert.Equal(t, "CHOSEN", decisions[reassignResponse["replaced_by"].(string)]["status"])
+	})
+
+	// Тест проверяет получение обоснования несуществующего PR.
+	// Ожидается: NOT_FOUND со статусом 404.
+	t.Run("GetReasoning - PR not found", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getReasoning?pull_request_id=missing", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,169 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/notification"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/postgres"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/reminder"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+type recordingNotifier struct {
+	digests []*domain.ReviewDigest
+}
+
+func (n *recordingNotifier) Send(ctx context.Context, digest *domain.ReviewDigest) error {
+	n.digests = append(n.digests, digest)
+	return nil
+}
+
+func TestAPI_ReviewReminders(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	newJob := func(notifier reminder.Notifier, now time.Time) *reminder.SendDigestsUseCase {
+		return reminder.NewSendDigestsUseCase(
+			postgres.NewPRRepository(db), postgres.NewUserRepository(db), postgres.NewNotificationSettingsRepository(db),
+			notifier, domain.FixedClock{Time: now},
+		)
+	}
+
+	setup := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Add search",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	// Тест проверяет установку и получение настроек напоминаний.
+	// Ожидается: статус 200, адрес нормализован, частота сохранена.
+	t.Run("SetNotifications and GetNotifications - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setNotifications", map[string]interface{}{
+			"user_id":   "u2",
+			"email":     "Bob <bob@example.com>",
+			"frequency": "WEEKLY",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/users/getNotifications?user_id=u2", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		settings := response["settings"].(map[string]interface{})
+		assert.Equal(t, "bob@example.com", settings["email"])
+		assert.Equal(t, "WEEKLY", settings["frequency"])
+	})
+
+	// Тест проверяет валидацию настроек напоминаний.
+	// Ожидается: INVALID_ARGUMENT со статусом 400 для неизвестной частоты и некорректного адреса.
+	t.Run("SetNotifications - invalid settings", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setNotifications", map[string]interface{}{
+			"user_id":   "u2",
+			"frequency": "HOURLY",
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/users/setNotifications", map[string]interface{}{
+			"user_id":   "u2",
+			"email":     "bob\r\nBcc: eve@example.com",
+			"frequency": "DAILY",
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	// Тест проверяет частоту дайджестов и отказ от напоминаний.
+	// Ожидается: повтор в тот же день не отправляется, через сутки отправляется; OFF исключает пользователя.
+	t.Run("SendDigests - respects frequency and opt-out", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setNotifications", map[string]interface{}{
+			"user_id":   "u3",
+			"frequency": "OFF",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		now := time.Now()
+		notifier := &recordingNotifier{}
+
+		results, err := newJob(notifier, now).Execute(context.Background())
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "u2", results[0].UserID)
+		require.Len(t, notifier.digests, 1)
+		assert.Equal(t, "pr-1", notifier.digests[0].PullRequests[0].ID)
+
+		results, err = newJob(notifier, now.Add(time.Hour)).Execute(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, results)
+
+		results, err = newJob(notifier, now.Add(25*time.Hour)).Execute(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	// Тест проверяет отправку дайджеста по SMTP на локальный фейковый сервер.
+	// Ожидается: письмо доставлено на адрес из настроек и содержит PR.
+	t.Run("SendDigests - SMTP notifier", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		setup(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setNotifications", map[string]interface{}{
+			"user_id":   "u2",
+			"email":     "bob@example.com",
+			"frequency": "DAILY",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		server, err := helpers.StartFakeSMTPServer()
+		require.NoError(t, err)
+		defer server.Close()
+
+		notifier := notification.NewSMTPNotifier(notification.SMTPConfig{
+			Host: server.Host(),
+			Port: server.Port(),
+			From: "pr-reviewer@example.com",
+		})
+
+		results, err := newJob(notifier, time.Now()).Execute(context.Background())
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.NoError(t, results[0].Err)
+
+		messages := server.Messages()
+		require.Len(t, messages, 1)
+		assert.Equal(t, []string{"bob@example.com"}, messages[0].To)
+		assert.Contains(t, messages[0].Data, "pr-1: Add search")
+	})
+}
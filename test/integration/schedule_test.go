@@ -0,0 +1,114 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_ScheduleEndpoints(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Пятница, 20:00 по Москве: московские ревьюеры выйдут на работу только в понедельник
+	fridayEvening := time.Date(2025, time.November, 14, 17, 0, 0, 0, time.UTC)
+	router := helpers.SetupTestAppWith(db, domain.FixedClock{Time: fridayEvening}, domain.NewRandomSource(1))
+
+	createTeam := func(t *testing.T) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u2", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "username": "Charlie", "is_active": true},
+				{"user_id": "u4", "username": "David", "is_active": true},
+				{"user_id": "u5", "username": "Eve", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	setSchedule := func(t *testing.T, userID, timeZone string) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setSchedule", map[string]interface{}{
+			"user_id":    userID,
+			"time_zone":  timeZone,
+			"work_start": "09:00",
+			"work_end":   "18:00",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	// Тест проверяет установку часового пояса и рабочего времени.
+	// Ожидается: статус 200, в ответе часовой пояс и рабочие часы пользователя.
+	t.Run("SetSchedule - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setSchedule", map[string]interface{}{
+			"user_id":    "u1",
+			"time_zone":  "Europe/Moscow",
+			"work_start": "10:00",
+			"work_end":   "19:00",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		user := response["user"].(map[string]interface{})
+		assert.Equal(t, "Europe/Moscow", user["time_zone"])
+		assert.Equal(t, "10:00", user["work_start"])
+		assert.Equal(t, "19:00", user["work_end"])
+	})
+
+	// Тест проверяет валидацию часового пояса и рабочего времени.
+	// Ожидается: INVALID_ARGUMENT со статусом 400.
+	t.Run("SetSchedule - invalid schedule", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setSchedule", map[string]interface{}{
+			"user_id":   "u1",
+			"time_zone": "Mars/Olympus",
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/users/setSchedule", map[string]interface{}{
+			"user_id":    "u1",
+			"time_zone":  "UTC",
+			"work_start": "18:00",
+			"work_end":   "09:00",
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	// Тест проверяет, что при создании PR предпочитаются ревьюеры в рабочем времени.
+	// Ожидается: назначены коллеги из Нью-Йорка и Лондона, а не из Москвы.
+	t.Run("CreatePR - prefers reviewers within working hours", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t)
+		setSchedule(t, "u2", "Europe/Moscow")
+		setSchedule(t, "u3", "America/New_York")
+		setSchedule(t, "u4", "Europe/London")
+		setSchedule(t, "u5", "Europe/Moscow")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		reviewers := response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		assert.ElementsMatch(t, []interface{}{"u3", "u4"}, reviewers)
+	})
+}
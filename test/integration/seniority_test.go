@@ -0,0 +1,123 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_SeniorityRule(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	createTeam := func(t *testing.T, members []map[string]interface{}) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members":   members,
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/setSeniorityRule", map[string]interface{}{
+			"team_name":     "backend",
+			"min_reviewers": 1,
+			"min_level":     "senior",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	// Тест проверяет, что при создании PR назначается ревьюер нужного уровня.
+	// Ожидается: единственный senior в команде попадает в ревьюеры, статус 201.
+	t.Run("CreatePR - assigns senior reviewer", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, []map[string]interface{}{
+			{"user_id": "u1", "username": "Alice", "is_active": true},
+			{"user_id": "u2", "username": "Bob", "is_active": true, "seniority": "junior"},
+			{"user_id": "u3", "username": "Charlie", "is_active": true, "seniority": "junior"},
+			{"user_id": "u4", "username": "David", "is_active": true, "seniority": "senior"},
+		})
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		reviewers := response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		assert.Contains(t, reviewers, "u4")
+		assert.Len(t, reviewers, 2)
+	})
+
+	// Тест проверяет, что замена senior-ревьюера тоже должна быть senior.
+	// Ожидается: при отсутствии другого senior возвращается SENIORITY_RULE_UNSATISFIED со статусом 409.
+	t.Run("ReassignReviewer - senior replacement required", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, []map[string]interface{}{
+			{"user_id": "u1", "username": "Alice", "is_active": true},
+			{"user_id": "u2", "username": "Bob", "is_active": true, "seniority": "junior"},
+			{"user_id": "u3", "username": "Charlie", "is_active": true, "seniority": "junior"},
+			{"user_id": "u4", "username": "David", "is_active": true, "seniority": "lead"},
+		})
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/pullRequest/reassign", map[string]interface{}{
+			"pull_request_id": "pr-1",
+			"old_user_id":     "u4",
+		})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		var errorResp map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &errorResp)
+		assert.Equal(t, "SENIORITY_RULE_UNSATISFIED", errorResp["error"].(map[string]interface{})["code"])
+	})
+
+	// Тест проверяет невозможность выполнить правило при создании PR.
+	// Ожидается: в команде нет senior, возвращается SENIORITY_RULE_UNSATISFIED со статусом 409.
+	t.Run("CreatePR - rule cannot be satisfied", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, []map[string]interface{}{
+			{"user_id": "u1", "username": "Alice", "is_active": true, "seniority": "senior"},
+			{"user_id": "u2", "username": "Bob", "is_active": true, "seniority": "middle"},
+		})
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	// Тест проверяет валидацию уровня пользователя.
+	// Ожидается: неизвестный уровень отклоняется со статусом 400.
+	t.Run("SetSeniority - invalid level", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, []map[string]interface{}{
+			{"user_id": "u1", "username": "Alice", "is_active": true},
+		})
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/users/setSeniority", map[string]interface{}{
+			"user_id":   "u1",
+			"seniority": "principal",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
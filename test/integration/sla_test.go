@@ -0,0 +1,269 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/internal/domain"
+	"github.com/avito-tech-backend-autumn-2025/internal/repository/postgres"
+	"github.com/avito-tech-backend-autumn-2025/internal/usecase/escalation"
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_ReviewSLA(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Назначения делаются по часам приложения, задача эскалации запускается
+	// через сутки с лишним: все текущие назначения просрочены
+	assignedAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+	router := helpers.SetupTestAppWith(db, domain.FixedClock{Time: assignedAt}, domain.NewSystemRandomSource())
+
+	newJobAt := func(now time.Time) *escalation.EscalateOverdueReviewsUseCase {
+		clock := domain.FixedClock{Time: now}
+		assigner := domain.NewReviewerAssigner(clock, domain.NewRandomSource(1), 24*time.Hour, domain.FairnessPolicy{})
+		defaultPolicy, _ := domain.NewSLAPolicy(24*time.Hour, domain.EscalationNotify)
+		return escalation.NewEscalateOverdueReviewsUseCase(
+			postgres.NewTransactor(db), postgres.NewPRRepository(db), postgres.NewTeamRepository(db), assigner, clock, defaultPolicy,
+		)
+	}
+	newJob := func() *escalation.EscalateOverdueReviewsUseCase {
+		return newJobAt(assignedAt.Add(25 * time.Hour))
+	}
+
+	createTeam := func(t *testing.T, memberIDs ...string) {
+		members := make([]map[string]interface{}, 0, len(memberIDs))
+		for _, id := range memberIDs {
+			members = append(members, map[string]interface{}{"user_id": id, "username": id, "is_active": true})
+		}
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members":   members,
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	setSLA := func(t *testing.T, reviewSLA, action string) {
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setSLA", map[string]interface{}{
+			"team_name":  "backend",
+			"review_sla": reviewSLA,
+			"action":     action,
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	createPR := func(t *testing.T) []interface{} {
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+	}
+
+	getEscalations := func(t *testing.T) []interface{} {
+		w := helpers.PerformRequest(router, http.MethodGet, "/pullRequest/getEscalations?pull_request_id=pr-1", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response["escalations"].([]interface{})
+	}
+
+	// Тест проверяет установку SLA команды.
+	// Ожидается: статус 200, политика возвращается в составе команды.
+	t.Run("SetSLA - success", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, "u1", "u2")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setSLA", map[string]interface{}{
+			"team_name":  "backend",
+			"review_sla": "8h",
+			"action":     "ADD_REVIEWER",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		sla := response["team"].(map[string]interface{})["sla"].(map[string]interface{})
+		assert.Equal(t, "8h0m0s", sla["review_sla"])
+		assert.Equal(t, "ADD_REVIEWER", sla["action"])
+	})
+
+	// Тест проверяет валидацию политики SLA.
+	// Ожидается: INVALID_ARGUMENT со статусом 400 для неизвестного действия и неположительного срока.
+	t.Run("SetSLA - invalid policy", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, "u1", "u2")
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/setSLA", map[string]interface{}{
+			"team_name":  "backend",
+			"review_sla": "8h",
+			"action":     "PANIC",
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = helpers.PerformRequest(router, http.MethodPost, "/team/setSLA", map[string]interface{}{
+			"team_name":  "backend",
+			"review_sla": "-1h",
+			"action":     "NOTIFY",
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	// Тест проверяет эскалацию с добавлением ревьюера, её однократность и лимит добавлений на PR.
+	// Ожидается: эскалация добавляет одного ревьюера, остальные просроченные ревью этого PR — NOTIFY;
+	// повторный запуск ничего не делает, а просроченный добавленный ревьюер не приводит к новому добавлению.
+	t.Run("Escalate - add reviewer", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, "u1", "u2", "u3", "u4", "u5")
+		setSLA(t, "24h", "ADD_REVIEWER")
+		reviewers := createPR(t)
+		require.Len(t, reviewers, 2)
+
+		escalations, err := newJob().Execute()
+		require.NoError(t, err)
+		require.Len(t, escalations, 2)
+		assert.Equal(t, domain.EscalationAddReviewer, escalations[0].Action)
+		assert.NotEmpty(t, escalations[0].NewReviewerID)
+		assert.Equal(t, domain.EscalationNotify, escalations[1].Action)
+		assert.NotEmpty(t, escalations[1].Details)
+
+		pullRequest, err := postgres.NewPRRepository(db).GetByID("pr-1")
+		require.NoError(t, err)
+		assert.Len(t, pullRequest.AssignedReviewers, 3)
+
+		// Добавленный ревьюер назначен только что и ещё не просрочен
+		escalations, err = newJob().Execute()
+		require.NoError(t, err)
+		assert.Empty(t, escalations)
+
+		// Через сутки просрочен и он, но лимит добавлений уже исчерпан
+		escalations, err = newJobAt(assignedAt.Add(50 * time.Hour)).Execute()
+		require.NoError(t, err)
+		require.Len(t, escalations, 1)
+		assert.Equal(t, domain.EscalationNotify, escalations[0].Action)
+
+		pullRequest, err = postgres.NewPRRepository(db).GetByID("pr-1")
+		require.NoError(t, err)
+		assert.Len(t, pullRequest.AssignedReviewers, 3)
+		assert.Len(t, getEscalations(t), 3)
+	})
+
+	// Тест проверяет эскалацию с заменой простаивающего ревьюера.
+	// Ожидается: оба ревьюера заменены через логику переназначения, замены записаны в историю назначений.
+	t.Run("Escalate - replace reviewer", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, "u1", "u2", "u3", "u4", "u5")
+		setSLA(t, "24h", "REPLACE_REVIEWER")
+		createPR(t)
+
+		escalations, err := newJob().Execute()
+		require.NoError(t, err)
+		require.Len(t, escalations, 2)
+		for _, e := range escalations {
+			assert.Equal(t, domain.EscalationReplaceReviewer, e.Action)
+			assert.NotEqual(t, e.ReviewerID, e.NewReviewerID)
+		}
+
+		pullRequest, err := postgres.NewPRRepository(db).GetByID("pr-1")
+		require.NoError(t, err)
+		assert.Len(t, pullRequest.AssignedReviewers, 2)
+
+		history, err := postgres.NewAssignmentHistoryRepository(db).GetByPRID("pr-1")
+		require.NoError(t, err)
+		assert.Len(t, history, 4)
+	})
+
+	// Тест проверяет откат к событию, если заменить ревьюера некем.
+	// Ожидается: эскалация записана как NOTIFY с описанием причины.
+	t.Run("Escalate - falls back to notify", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, "u1", "u2")
+		setSLA(t, "24h", "REPLACE_REVIEWER")
+		createPR(t)
+
+		escalations, err := newJob().Execute()
+		require.NoError(t, err)
+		require.Len(t, escalations, 1)
+		assert.Equal(t, domain.EscalationNotify, escalations[0].Action)
+		assert.NotEmpty(t, escalations[0].Details)
+
+		recorded := getEscalations(t)
+		require.Len(t, recorded, 1)
+		assert.Equal(t, "NOTIFY", recorded[0].(map[string]interface{})["action"])
+	})
+
+	// Тест проверяет, что PR в пределах SLA не эскалируются.
+	// Ожидается: при SLA 48 часов эскалаций нет.
+	t.Run("Escalate - within SLA", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, "u1", "u2", "u3")
+		setSLA(t, "48h", "NOTIFY")
+		createPR(t)
+
+		escalations, err := newJob().Execute()
+		require.NoError(t, err)
+		assert.Empty(t, escalations)
+	})
+
+	// Тест проверяет, что срок SLA отсчитывается от времени назначения по часам приложения.
+	// Ожидается: за минуту до истечения SLA эскалаций нет, через минуту после — эскалированы оба ревьюера.
+	t.Run("Escalate - SLA measured by application clock", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		createTeam(t, "u1", "u2", "u3")
+		setSLA(t, "24h", "NOTIFY")
+		createPR(t)
+
+		escalations, err := newJobAt(assignedAt.Add(24*time.Hour - time.Minute)).Execute()
+		require.NoError(t, err)
+		assert.Empty(t, escalations)
+
+		escalations, err = newJobAt(assignedAt.Add(24*time.Hour + time.Minute)).Execute()
+		require.NoError(t, err)
+		assert.Len(t, escalations, 2)
+	})
+
+	// Тест проверяет SLA при часах приложения не в UTC.
+	// Ожидается: смещение пояса не сдвигает срок — за минуту до истечения эскалаций нет, через минуту после — есть.
+	t.Run("Escalate - SLA with non-UTC application clock", func(t *testing.T) {
+		helpers.CleanupDB(db)
+		zone := time.FixedZone("UTC+3", 3*60*60)
+		zoned := helpers.SetupTestAppWith(db, domain.FixedClock{Time: assignedAt.In(zone)}, domain.NewSystemRandomSource())
+
+		w := helpers.PerformRequest(zoned, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "u1", "is_active": true},
+				{"user_id": "u2", "username": "u2", "is_active": true},
+				{"user_id": "u3", "username": "u3", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+		setSLA(t, "24h", "NOTIFY")
+		w = helpers.PerformRequest(zoned, http.MethodPost, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Test PR",
+			"author_id":         "u1",
+		})
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		escalations, err := newJobAt(assignedAt.In(zone).Add(24*time.Hour - time.Minute)).Execute()
+		require.NoError(t, err)
+		assert.Empty(t, escalations)
+
+		escalations, err = newJobAt(assignedAt.In(zone).Add(24*time.Hour + time.Minute)).Execute()
+		require.NoError(t, err)
+		assert.Len(t, escalations, 2)
+	})
+}
@@ -0,0 +1,175 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avito-tech-backend-autumn-2025/test/helpers"
+)
+
+func TestAPI_RequestValidation(t *testing.T) {
+	db, cleanup, err := helpers.SetupTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	router := helpers.SetupTestApp(db)
+
+	// fieldErrors возвращает нарушенное правило по пути каждого поля
+	fieldErrors := func(t *testing.T, method, path string, body interface{}) map[string]string {
+		w := helpers.PerformRequest(router, method, path, body)
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+		var response struct {
+			Error struct {
+				Code    string `json:"code"`
+				Details struct {
+					Fields []struct {
+						Field string `json:"field"`
+						Rule  string `json:"rule"`
+					} `json:"fields"`
+				} `json:"details"`
+			} `json:"error"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INVALID_REQUEST", response.Error.Code)
+
+		fields := make(map[string]string)
+		for _, detail := range response.Error.Details.Fields {
+			fields[detail.Field] = detail.Rule
+		}
+		return fields
+	}
+
+	// Тест проверяет обязательные поля, длину и символы идентификаторов
+	// Ожидается: 400 INVALID_REQUEST с каждым неверным полем, команда не создана
+	t.Run("Create team fields", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		fields := fieldErrors(t, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": strings.Repeat("a", 256), "is_active": true},
+				{"user_id": "bad id", "username": "Bob", "is_active": true},
+				{"user_id": "u3", "is_active": true},
+			},
+		})
+
+		assert.Equal(t, map[string]string{
+			"team_name":           "required",
+			"members[0].username": "max",
+			"members[1].user_id":  "id",
+			"members[2].username": "required",
+		}, fields)
+
+		w := helpers.PerformRequest(router, http.MethodGet, "/team/get?team_name=backend", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	// Тест проверяет повтор участников команды
+	// Ожидается: 400 с полем members и правилом unique, в том числе в API v2
+	t.Run("Duplicate members", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		body := map[string]interface{}{
+			"team_name": "backend",
+			"members": []map[string]interface{}{
+				{"user_id": "u1", "username": "Alice", "is_active": true},
+				{"user_id": "u1", "username": "Alice again", "is_active": true},
+			},
+		}
+
+		assert.Equal(t, map[string]string{"members": "unique"}, fieldErrors(t, http.MethodPost, "/team/add", body))
+		assert.Equal(t, map[string]string{"members": "unique"}, fieldErrors(t, http.MethodPost, "/api/v2/teams", body))
+	})
+
+	// Тест проверяет вложенные элементы пакетного запроса
+	// Ожидается: путь к полю содержит индекс элемента; пустой пакет — правило min
+	t.Run("Batch items", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		fields := fieldErrors(t, http.MethodPost, "/pullRequest/batchCreate", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"pull_request_id": "pr-1", "pull_request_name": "One", "author_id": "u1", "labels": []string{""}},
+				{"pull_request_name": "Two", "author_id": "u1"},
+			},
+		})
+		assert.Equal(t, map[string]string{
+			"items[0].labels[0]":       "required",
+			"items[1].pull_request_id": "required",
+		}, fields)
+
+		fields = fieldErrors(t, http.MethodPost, "/pullRequest/batchMerge", map[string]interface{}{
+			"items": []map[string]interface{}{},
+		})
+		assert.Equal(t, map[string]string{"items": "min"}, fields)
+	})
+
+	// Тест проверяет параметры списка PR и тело без JSON
+	// Ожидается: некорректный author_id — поле в details; неразбираемое тело — 400 без details
+	t.Run("Query and malformed body", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		fields := fieldErrors(t, http.MethodGet, "/pullRequest/list?author_id=a%20b", nil)
+		assert.Equal(t, map[string]string{"author_id": "id"}, fields)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/pullRequest/merge", "not json")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "invalid request body", response["error"]["message"])
+		assert.NotContains(t, response["error"], "details")
+	})
+
+	// Тест проверяет идентификаторы из букв другого алфавита
+	// Ожидается: команда с кириллическими именами создаётся и читается через API v2
+	t.Run("Unicode identifiers", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		w := helpers.PerformRequest(router, http.MethodPost, "/team/add", map[string]interface{}{
+			"team_name": "бэкенд",
+			"members": []map[string]interface{}{
+				{"user_id": "пользователь-1", "username": "Алиса", "is_active": true},
+			},
+		})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/api/v2/users/"+url.PathEscape("пользователь-1"), nil)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = helpers.PerformRequest(router, http.MethodGet, "/api/v2/teams/"+url.PathEscape("бэкенд"), nil)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+
+	// Тест проверяет параметры пути API v2
+	// Ожидается: 400 INVALID_REQUEST с именем параметра в details
+	t.Run("V2 path parameters", func(t *testing.T) {
+		helpers.CleanupDB(db)
+
+		tests := []struct {
+			name   string
+			method string
+			path   string
+			body   interface{}
+			want   map[string]string
+		}{
+			{"get user", http.MethodGet, "/api/v2/users/bad%20id", nil, map[string]string{"user_id": "id"}},
+			{"update user", http.MethodPatch, "/api/v2/users/bad%20id", map[string]interface{}{"is_active": false}, map[string]string{"user_id": "id"}},
+			{"get team", http.MethodGet, "/api/v2/teams/bad%20team", nil, map[string]string{"team_name": "id"}},
+			{"get PR", http.MethodGet, "/api/v2/pull-requests/pr%201", nil, map[string]string{"pull_request_id": "id"}},
+			{"reassign", http.MethodPost, "/api/v2/pull-requests/pr-1/reviewers/bad%20id:reassign", nil, map[string]string{"reviewer_id": "id"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, fieldErrors(t, tt.method, tt.path, tt.body))
+			})
+		}
+	})
+}